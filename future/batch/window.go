@@ -0,0 +1,147 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+)
+
+// ErrClosed is the error of futures added to a closed Window.
+var ErrClosed = errors.New("future/batch: window is closed")
+
+// Window collects keys added within a time window and resolves them
+// together with a single call to fn.
+type Window[K comparable, V any] struct {
+	maxBatch int
+	fn       func(ctx context.Context, keys []K) ([]V, error)
+
+	mu      sync.Mutex
+	pending []request[K, V]
+	closed  bool
+
+	full    chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+type request[K comparable, V any] struct {
+	key    K
+	result chan future.Result[V]
+}
+
+// NewWindow starts a window that flushes every windowDuration, or as soon as
+// maxBatch keys are pending. A maxBatch of 0 or less does not limit the batch size.
+// A windowDuration of 0 or less only flushes on maxBatch and Close.
+// fn must return one value per key, in the order of keys.
+func NewWindow[K comparable, V any](windowDuration time.Duration, maxBatch int, fn func(ctx context.Context, keys []K) ([]V, error)) *Window[K, V] {
+	w := &Window[K, V]{
+		maxBatch: maxBatch,
+		fn:       fn,
+		full:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+	go w.run(windowDuration)
+	return w
+}
+
+// Add queues key for the next batch and returns a future of its value.
+func (w *Window[K, V]) Add(ctx context.Context, key K) *future.Future[V] {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return future.Err[V](ctx, ErrClosed)
+	}
+	r := request[K, V]{key: key, result: make(chan future.Result[V], 1)}
+	w.pending = append(w.pending, r)
+	if w.maxBatch > 0 && len(w.pending) >= w.maxBatch {
+		select {
+		case w.full <- struct{}{}:
+		default:
+		}
+	}
+	w.mu.Unlock()
+
+	return future.New(ctx, func(ctx context.Context) (V, error) {
+		select {
+		case res := <-r.result:
+			return res.Val, res.Err
+		case <-ctx.Done():
+			var defaultV V
+			return defaultV, ctx.Err()
+		}
+	})
+}
+
+// Close flushes the pending keys and stops the window.
+// Keys added after Close fail with ErrClosed.
+func (w *Window[K, V]) Close() {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		<-w.stopped
+		return
+	}
+	w.closed = true
+	w.mu.Unlock()
+	close(w.done)
+	<-w.stopped
+}
+
+func (w *Window[K, V]) run(windowDuration time.Duration) {
+	defer close(w.stopped)
+	var tick <-chan time.Time
+	if windowDuration > 0 {
+		ticker := time.NewTicker(windowDuration)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-tick:
+		case <-w.full:
+		case <-w.done:
+			w.flush()
+			return
+		}
+		w.flush()
+	}
+}
+
+func (w *Window[K, V]) flush() {
+	w.mu.Lock()
+	pending := w.pending
+	w.pending = nil
+	w.mu.Unlock()
+
+	for len(pending) > 0 {
+		n := len(pending)
+		if w.maxBatch > 0 {
+			n = min(n, w.maxBatch)
+		}
+		w.resolve(pending[:n])
+		pending = pending[n:]
+	}
+}
+
+func (w *Window[K, V]) resolve(batch []request[K, V]) {
+	keys := make([]K, len(batch))
+	for i, r := range batch {
+		keys[i] = r.key
+	}
+	vals, err := w.fn(context.Background(), keys)
+	if err == nil && len(vals) != len(keys) {
+		err = fmt.Errorf("future/batch: expected %d values, got %d", len(keys), len(vals))
+	}
+	for i, r := range batch {
+		if err != nil {
+			r.result <- future.Result[V]{Err: err}
+			continue
+		}
+		r.result <- future.Result[V]{Val: vals[i]}
+	}
+}
@@ -0,0 +1,55 @@
+package future
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// SendOnSettle sends the value of f to ch, or its error to errCh, once f settles.
+// Both channels must be able to receive, or the internal goroutine blocks forever.
+func SendOnSettle[T any](f *Future[T], ch chan<- T, errCh chan<- error) {
+	go func() {
+		val, err := f.TryGet(context.Background())
+		if err != nil {
+			errCh <- err
+			return
+		}
+		ch <- val
+	}()
+}
+
+// WaitGroupFuture settles once wg.Wait returns, or with ctx.Err() if ctx is done first.
+func WaitGroupFuture(ctx context.Context, wg *sync.WaitGroup) *Future[struct{}] {
+	return New(ctx, func(ctx context.Context) (struct{}, error) {
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+			return struct{}{}, nil
+		case <-ctx.Done():
+			return struct{}{}, ctx.Err()
+		}
+	})
+}
+
+// NewFromChan resolves with the first value received from ch. It fails with
+// io.ErrUnexpectedEOF if ch is closed before a value is sent, or with
+// ctx.Err() if ctx is done first.
+func NewFromChan[T any](ctx context.Context, ch <-chan T) *Future[T] {
+	return New(ctx, func(ctx context.Context) (T, error) {
+		select {
+		case val, ok := <-ch:
+			if !ok {
+				return val, io.ErrUnexpectedEOF
+			}
+			return val, nil
+		case <-ctx.Done():
+			var defaultT T
+			return defaultT, ctx.Err()
+		}
+	})
+}
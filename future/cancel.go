@@ -0,0 +1,67 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// NewWithCancel is like New, but also returns a function that cancels the computation.
+func NewWithCancel[T any](ctx context.Context, fun func(ctx context.Context) (T, error)) (*Future[T], context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	return New(ctx, fun), cancel
+}
+
+// NewWithCancelCause is like NewWithCancel, but the returned function takes a
+// cause. If the computation fails because of the cancellation, the future
+// settles with the cause instead of context.Canceled.
+func NewWithCancelCause[T any](ctx context.Context, fun func(ctx context.Context) (T, error)) (*Future[T], context.CancelCauseFunc) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	f := New(ctx, func(ctx context.Context) (T, error) {
+		val, err := fun(ctx)
+		if errors.Is(err, context.Canceled) {
+			if cause := context.Cause(ctx); cause != nil {
+				err = cause
+			}
+		}
+		return val, err
+	})
+	return f, cancel
+}
+
+// CancelGroup cancels a set of related computations together.
+type CancelGroup struct {
+	mu      sync.Mutex
+	cancels []context.CancelCauseFunc
+}
+
+func NewCancelGroup() *CancelGroup {
+	return &CancelGroup{}
+}
+
+func (g *CancelGroup) Add(cancel context.CancelFunc) {
+	g.AddWithCause(func(error) {
+		cancel()
+	})
+}
+
+// AddWithCause adds a cancel function that receives the cause passed to CancelAllWithCause.
+func (g *CancelGroup) AddWithCause(cancel context.CancelCauseFunc) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.cancels = append(g.cancels, cancel)
+}
+
+func (g *CancelGroup) CancelAll() {
+	g.CancelAllWithCause(nil)
+}
+
+// CancelAllWithCause cancels every function in the group. Functions added
+// with Add are canceled without a cause.
+func (g *CancelGroup) CancelAllWithCause(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, cancel := range g.cancels {
+		cancel(err)
+	}
+}
@@ -0,0 +1,408 @@
+package future
+
+import (
+	"cmp"
+	"context"
+	"iter"
+	"slices"
+)
+
+type settled[T any] struct {
+	index int
+	val   T
+	err   error
+}
+
+// settleEach awaits every future concurrently and reports each outcome in
+// completion order. The channel is buffered, so callers may stop receiving early.
+func settleEach[T any](ctx context.Context, futures []*Future[T]) <-chan settled[T] {
+	ch := make(chan settled[T], len(futures))
+	for i, f := range futures {
+		go func() {
+			val, err := f.TryGet(ctx)
+			ch <- settled[T]{index: i, val: val, err: err}
+		}()
+	}
+	return ch
+}
+
+// Reduce awaits the futures in input order and folds their values into an
+// accumulator, starting from initial. The futures keep running concurrently;
+// only the folding happens sequentially. The first error aborts the fold.
+func Reduce[T any, U any](ctx context.Context, futures []*Future[T], initial U, fun func(acc U, val T) U) (U, error) {
+	acc := initial
+	for _, f := range futures {
+		val, err := f.TryGet(ctx)
+		if err != nil {
+			var defaultU U
+			return defaultU, err
+		}
+		acc = fun(acc, val)
+	}
+	return acc, nil
+}
+
+// FoldLeft awaits the futures strictly left to right, threading the
+// accumulator through fun after each one.
+func FoldLeft[T any, U any](ctx context.Context, futures []*Future[T], initial U, fun func(ctx context.Context, acc U, val T) (U, error)) *Future[U] {
+	return New(ctx, func(ctx context.Context) (U, error) {
+		return fold(ctx, futures, initial, fun, func(i int) int { return i })
+	})
+}
+
+// FoldRight is like FoldLeft, but awaits the futures right to left.
+func FoldRight[T any, U any](ctx context.Context, futures []*Future[T], initial U, fun func(ctx context.Context, acc U, val T) (U, error)) *Future[U] {
+	return New(ctx, func(ctx context.Context) (U, error) {
+		return fold(ctx, futures, initial, fun, func(i int) int { return len(futures) - 1 - i })
+	})
+}
+
+func fold[T any, U any](ctx context.Context, futures []*Future[T], initial U, fun func(ctx context.Context, acc U, val T) (U, error), index func(i int) int) (U, error) {
+	acc := initial
+	for i := range futures {
+		val, err := futures[index(i)].TryGet(ctx)
+		if err != nil {
+			var defaultU U
+			return defaultU, passOn(ctx, err)
+		}
+		acc, err = fun(ctx, acc, val)
+		if err != nil {
+			var defaultU U
+			return defaultU, err
+		}
+	}
+	return acc, nil
+}
+
+// AllFromChan drains ch until it is closed and awaits every received future,
+// returning their values in arrival order.
+func AllFromChan[T any](ctx context.Context, ch <-chan *Future[T]) ([]T, error) {
+	var futures []*Future[T]
+	for {
+		select {
+		case f, ok := <-ch:
+			if !ok {
+				return All(ctx, futures)
+			}
+			futures = append(futures, f)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// MaxBy returns the value with the greatest key, preferring the lowest index on ties.
+// It fails as soon as any of the futures fails.
+func MaxBy[T any, U cmp.Ordered](ctx context.Context, futures []*Future[T], key func(T) U) (T, error) {
+	return extremeBy(ctx, futures, key, func(a, b U) bool { return a > b })
+}
+
+// MinBy returns the value with the smallest key, preferring the lowest index on ties.
+// It fails as soon as any of the futures fails.
+func MinBy[T any, U cmp.Ordered](ctx context.Context, futures []*Future[T], key func(T) U) (T, error) {
+	return extremeBy(ctx, futures, key, func(a, b U) bool { return a < b })
+}
+
+func extremeBy[T any, U cmp.Ordered](ctx context.Context, futures []*Future[T], key func(T) U, better func(a, b U) bool) (T, error) {
+	var defaultT T
+	if len(futures) == 0 {
+		return defaultT, ErrEmptySlice
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	bestIndex := -1
+	var best T
+	var bestKey U
+	results := settleEach(ctx, futures)
+	for range futures {
+		r := <-results
+		if r.err != nil {
+			return defaultT, r.err
+		}
+		k := key(r.val)
+		if bestIndex == -1 || better(k, bestKey) || (k == bestKey && r.index < bestIndex) {
+			bestIndex, best, bestKey = r.index, r.val, k
+		}
+	}
+	return best, nil
+}
+
+// Contains reports whether any of the futures resolves to target, settling
+// as soon as a match is found. If there is no match and some futures failed,
+// their errors are returned since the answer cannot be known.
+func Contains[T comparable](ctx context.Context, futures []*Future[T], target T) *Future[bool] {
+	return New(ctx, func(ctx context.Context) (bool, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		var errs []error
+		results := settleEach(ctx, futures)
+		for range futures {
+			r := <-results
+			if r.err != nil {
+				errs = append(errs, r.err)
+				continue
+			}
+			if r.val == target {
+				return true, nil
+			}
+		}
+		return false, passOn(ctx, newAggregateError(errs))
+	})
+}
+
+// Number is satisfied by every integer and floating point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum adds up the values of the futures. The sum of no futures is zero.
+func Sum[T Number](ctx context.Context, futures []*Future[T]) *Future[T] {
+	return New(ctx, func(ctx context.Context) (T, error) {
+		vals, err := All(ctx, futures)
+		if err != nil {
+			return 0, passOn(ctx, err)
+		}
+		var sum T
+		for _, val := range vals {
+			sum += val
+		}
+		return sum, nil
+	})
+}
+
+// Product multiplies the values of the futures. The product of no futures is one.
+func Product[T Number](ctx context.Context, futures []*Future[T]) *Future[T] {
+	return New(ctx, func(ctx context.Context) (T, error) {
+		vals, err := All(ctx, futures)
+		if err != nil {
+			return 0, passOn(ctx, err)
+		}
+		var product T = 1
+		for _, val := range vals {
+			product *= val
+		}
+		return product, nil
+	})
+}
+
+// Unique resolves with the distinct values of the futures in first-seen order.
+func Unique[T comparable](ctx context.Context, futures []*Future[T]) *Future[[]T] {
+	return New(ctx, func(ctx context.Context) ([]T, error) {
+		vals, err := All(ctx, futures)
+		if err != nil {
+			return nil, passOn(ctx, err)
+		}
+		seen := make(map[T]struct{}, len(vals))
+		unique := make([]T, 0, len(vals))
+		for _, val := range vals {
+			if _, ok := seen[val]; ok {
+				continue
+			}
+			seen[val] = struct{}{}
+			unique = append(unique, val)
+		}
+		return unique, nil
+	})
+}
+
+// SortedBy resolves with the values of the futures sorted ascending by key.
+// Values with equal keys keep their input order.
+func SortedBy[T any, U cmp.Ordered](ctx context.Context, futures []*Future[T], key func(T) U) *Future[[]T] {
+	return sortedBy(ctx, futures, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+// SortedByDesc is like SortedBy, but sorts descending.
+func SortedByDesc[T any, U cmp.Ordered](ctx context.Context, futures []*Future[T], key func(T) U) *Future[[]T] {
+	return sortedBy(ctx, futures, func(a, b T) int {
+		return cmp.Compare(key(b), key(a))
+	})
+}
+
+func sortedBy[T any](ctx context.Context, futures []*Future[T], compare func(a, b T) int) *Future[[]T] {
+	return New(ctx, func(ctx context.Context) ([]T, error) {
+		vals, err := All(ctx, futures)
+		if err != nil {
+			return nil, passOn(ctx, err)
+		}
+		slices.SortStableFunc(vals, compare)
+		return vals, nil
+	})
+}
+
+// GroupResult resolves with the values of the futures grouped by key,
+// keeping input order within each group.
+func GroupResult[K comparable, T any](ctx context.Context, futures []*Future[T], key func(T) K) *Future[map[K][]T] {
+	return New(ctx, func(ctx context.Context) (map[K][]T, error) {
+		vals, err := All(ctx, futures)
+		if err != nil {
+			return nil, passOn(ctx, err)
+		}
+		groups := make(map[K][]T)
+		for _, val := range vals {
+			k := key(val)
+			groups[k] = append(groups[k], val)
+		}
+		return groups, nil
+	})
+}
+
+// Range iterates over the outcomes of the futures in completion order.
+// Iteration stops early if ctx is done.
+func Range[T any](ctx context.Context, futures []*Future[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		waitCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := settleEach(waitCtx, futures)
+		for range futures {
+			select {
+			case r := <-results:
+				if r.err != nil && ctx.Err() != nil && r.err == ctx.Err() {
+					return
+				}
+				if !yield(r.val, r.err) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// AllSettled waits for every future and returns their outcomes in input order.
+// Unlike All, it never stops early on a failure.
+func AllSettled[T any](ctx context.Context, futures []*Future[T]) []Result[T] {
+	out := make([]Result[T], len(futures))
+	results := settleEach(ctx, futures)
+	for range futures {
+		r := <-results
+		out[r.index] = Result[T]{Val: r.val, Err: r.err}
+	}
+	return out
+}
+
+// Any returns the value of the first future to succeed.
+// If every future fails, their errors are returned together.
+func Any[T any](ctx context.Context, futures []*Future[T]) (T, error) {
+	val, _, err := AnyIndex(ctx, futures)
+	return val, err
+}
+
+// Race returns the outcome of the first future to settle, whether it
+// succeeded or failed.
+func Race[T any](ctx context.Context, futures []*Future[T]) (T, error) {
+	if len(futures) == 0 {
+		var defaultT T
+		return defaultT, ErrEmptySlice
+	}
+
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	r := <-settleEach(waitCtx, futures)
+	return r.val, r.err
+}
+
+// AnyIndex returns the value and index of the first future to succeed.
+// If every future fails, the index is -1 and the errors are aggregated.
+func AnyIndex[T any](ctx context.Context, futures []*Future[T]) (T, int, error) {
+	var defaultT T
+	if len(futures) == 0 {
+		return defaultT, -1, ErrEmptySlice
+	}
+
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var errs []error
+	results := settleEach(waitCtx, futures)
+	for range futures {
+		r := <-results
+		if r.err == nil {
+			return r.val, r.index, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return defaultT, -1, err
+		}
+		errs = append(errs, r.err)
+	}
+	return defaultT, -1, newAggregateError(errs)
+}
+
+// AwaitAll waits for every future to settle and returns the first error
+// to occur, or nil if all succeeded. Unlike All, the values are discarded.
+func AwaitAll[T any](ctx context.Context, futures []*Future[T]) error {
+	var first error
+	results := settleEach(ctx, futures)
+	for range futures {
+		r := <-results
+		if r.err != nil && first == nil {
+			first = r.err
+		}
+	}
+	return first
+}
+
+// AwaitAny waits for the first future to settle and returns its index,
+// together with its error if it failed.
+func AwaitAny[T any](ctx context.Context, futures []*Future[T]) (int, error) {
+	if len(futures) == 0 {
+		return -1, ErrEmptySlice
+	}
+
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	r := <-settleEach(waitCtx, futures)
+	return r.index, r.err
+}
+
+// EachSettled calls fun for every future as it settles, in settlement order.
+// Failures do not stop the iteration. fun runs in the calling goroutine.
+func EachSettled[T any](ctx context.Context, futures []*Future[T], fun func(index int, val T, err error)) {
+	results := settleEach(ctx, futures)
+	for range futures {
+		r := <-results
+		fun(r.index, r.val, r.err)
+	}
+}
+
+// WhenDone calls fun once every future has settled, with their values and
+// errors in input order. The returned future settles when fun returns.
+func WhenDone[T any](ctx context.Context, futures []*Future[T], fun func(ctx context.Context, results []T, errs []error)) *Future[struct{}] {
+	return New(ctx, func(ctx context.Context) (struct{}, error) {
+		results := make([]T, len(futures))
+		errs := make([]error, len(futures))
+		EachSettled(ctx, futures, func(index int, val T, err error) {
+			results[index] = val
+			errs[index] = err
+		})
+		fun(ctx, results, errs)
+		return struct{}{}, nil
+	})
+}
+
+// Collect folds the values of the futures into zero with add, in input order.
+// Unlike Reduce, it fails as soon as any future fails.
+func Collect[T any, U any](ctx context.Context, futures []*Future[T], zero U, add func(U, T) U) *Future[U] {
+	return New(ctx, func(ctx context.Context) (U, error) {
+		vals, err := All(ctx, futures)
+		if err != nil {
+			var defaultU U
+			return defaultU, passOn(ctx, err)
+		}
+		acc := zero
+		for _, val := range vals {
+			acc = add(acc, val)
+		}
+		return acc, nil
+	})
+}
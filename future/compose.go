@@ -0,0 +1,79 @@
+package future
+
+import (
+	"context"
+)
+
+// After starts fun once dep has settled successfully.
+// If dep fails, the returned future fails with the same error and fun is never called.
+func After[T any](ctx context.Context, dep *Future[any], fun func(ctx context.Context) (T, error)) *Future[T] {
+	return New(ctx, func(ctx context.Context) (T, error) {
+		if _, err := dep.TryGet(ctx); err != nil {
+			var defaultT T
+			return defaultT, passOn(ctx, err)
+		}
+		return fun(ctx)
+	})
+}
+
+// Compose chains two future-returning functions into one.
+func Compose[A any, B any, C any](f func(ctx context.Context, a A) *Future[B], g func(ctx context.Context, b B) *Future[C]) func(ctx context.Context, a A) *Future[C] {
+	return func(ctx context.Context, a A) *Future[C] {
+		return FlatMap(f(ctx, a), g)
+	}
+}
+
+// Compose3 chains three future-returning functions into one.
+func Compose3[A any, B any, C any, D any](f func(ctx context.Context, a A) *Future[B], g func(ctx context.Context, b B) *Future[C], h func(ctx context.Context, c C) *Future[D]) func(ctx context.Context, a A) *Future[D] {
+	return Compose(Compose(f, g), h)
+}
+
+// Pipe applies each transform to f in order.
+func Pipe[T any](f *Future[T], transforms ...func(*Future[T]) *Future[T]) *Future[T] {
+	for _, transform := range transforms {
+		f = transform(f)
+	}
+	return f
+}
+
+// Chainable wraps a future to allow method-style chaining.
+// Since methods cannot declare type parameters, every step keeps the value
+// type T; use the package-level FlatMap and Map to change it.
+type Chainable[T any] struct {
+	f *Future[T]
+}
+
+func Chain[T any](f *Future[T]) Chainable[T] {
+	return Chainable[T]{f: f}
+}
+
+func (c Chainable[T]) AndThen(fun func(ctx context.Context, val T) *Future[T]) Chainable[T] {
+	return Chain(FlatMap(c.f, fun))
+}
+
+func (c Chainable[T]) Map(fun func(ctx context.Context, val T) T) Chainable[T] {
+	return Chain(Map(c.f, fun))
+}
+
+func (c Chainable[T]) TryGet(ctx context.Context) (T, error) {
+	return c.f.TryGet(ctx)
+}
+
+func (c Chainable[T]) Future() *Future[T] {
+	return c.f
+}
+
+// Cond awaits condition and then runs only the matching branch.
+func Cond[T any](ctx context.Context, condition *Future[bool], ifTrue func(ctx context.Context) (T, error), ifFalse func(ctx context.Context) (T, error)) *Future[T] {
+	return New(ctx, func(ctx context.Context) (T, error) {
+		ok, err := condition.TryGet(ctx)
+		if err != nil {
+			var defaultT T
+			return defaultT, passOn(ctx, err)
+		}
+		if ok {
+			return ifTrue(ctx)
+		}
+		return ifFalse(ctx)
+	})
+}
@@ -0,0 +1,82 @@
+package encoding
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/Olian04/go-future/future"
+)
+
+const (
+	statePending = "pending"
+	stateDone    = "done"
+	stateError   = "error"
+)
+
+// FutureJSON wraps a future to encode it as JSON without blocking.
+// Settled futures carry their value or error message; pending futures only
+// carry their state.
+type FutureJSON[T any] struct {
+	*future.Future[T]
+}
+
+type futureJSON struct {
+	State string          `json:"state"`
+	Value json.RawMessage `json:"value,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// MarshalJSON encodes the state of the future, or null if there is none.
+func (f FutureJSON[T]) MarshalJSON() ([]byte, error) {
+	if f.Future == nil {
+		return []byte("null"), nil
+	}
+	switch f.State() {
+	case future.StateDone:
+		val, _ := f.TryGet(context.Background())
+		raw, err := json.Marshal(val)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(futureJSON{State: stateDone, Value: raw})
+	case future.StateError:
+		encoded := futureJSON{State: stateError}
+		if _, err := f.TryGet(context.Background()); err != nil {
+			encoded.Error = err.Error()
+		}
+		return json.Marshal(encoded)
+	default:
+		return json.Marshal(futureJSON{State: statePending})
+	}
+}
+
+// UnmarshalJSON decodes a settled future. Pending futures cannot be decoded
+// since there is no computation left to wait for.
+func (f *FutureJSON[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		f.Future = nil
+		return nil
+	}
+	var decoded futureJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	ctx := context.Background()
+	switch decoded.State {
+	case stateDone:
+		var val T
+		if err := json.Unmarshal(decoded.Value, &val); err != nil {
+			return err
+		}
+		f.Future = future.Ok(ctx, val)
+	case stateError:
+		f.Future = future.Err[T](ctx, errors.New(decoded.Error))
+	case statePending:
+		return errors.New("future/encoding: cannot unmarshal a pending future")
+	default:
+		return fmt.Errorf("future/encoding: unknown state %q", decoded.State)
+	}
+	return nil
+}
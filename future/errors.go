@@ -0,0 +1,113 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrEmptySlice         = errors.New("future: empty slice")
+	ErrExpectedExactlyOne = errors.New("future: expected exactly one element")
+)
+
+// AggregateError collects the errors of several failed futures.
+// It supports errors.Is and errors.As through multi-error unwrapping.
+type AggregateError struct {
+	errs []error
+}
+
+// newAggregateError returns nil for no errors, the error itself for a single
+// error and an *AggregateError otherwise.
+func newAggregateError(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+	return &AggregateError{errs: errs}
+}
+
+func (e *AggregateError) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *AggregateError) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d errors occurred:", len(e.errs))
+	for _, err := range e.errs {
+		fmt.Fprintf(&b, "\n\t* %s", err)
+	}
+	return b.String()
+}
+
+func (e *AggregateError) Errors() []error {
+	return append([]error(nil), e.errs...)
+}
+
+func (e *AggregateError) Unwrap() []error {
+	return e.errs
+}
+
+// MapKeyError reports which key of a map failed to process.
+type MapKeyError[K comparable] struct {
+	Key K
+	Err error
+}
+
+func (e *MapKeyError[K]) Error() string {
+	return fmt.Sprintf("future: key %v: %v", e.Key, e.Err)
+}
+
+func (e *MapKeyError[K]) Unwrap() error {
+	return e.Err
+}
+
+// TypeAssertionError is returned when a value does not have the expected type.
+type TypeAssertionError struct {
+	Actual   string
+	Expected string
+}
+
+func (e *TypeAssertionError) Error() string {
+	return fmt.Sprintf("future: value of type %s is not %s", e.Actual, e.Expected)
+}
+
+func IsContextCanceled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+func IsDeadlineExceeded(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+// IsContextError reports whether err is caused by a canceled or expired context.
+func IsContextError(err error) bool {
+	return IsContextCanceled(err) || IsDeadlineExceeded(err)
+}
+
+// FutureError annotates the error of a future created with WithDebug with
+// the name of the future and the location it was created at.
+type FutureError struct {
+	Cause      error
+	FutureName string
+	File       string
+	Line       int
+}
+
+func (e *FutureError) Error() string {
+	if e.FutureName == "" {
+		return fmt.Sprintf("future created at %s:%d: %v", e.File, e.Line, e.Cause)
+	}
+	return fmt.Sprintf("future %q created at %s:%d: %v", e.FutureName, e.File, e.Line, e.Cause)
+}
+
+func (e *FutureError) Unwrap() error {
+	return e.Cause
+}
@@ -0,0 +1,48 @@
+package future
+
+import (
+	"context"
+	"sync"
+)
+
+// Fuse invokes a function until it fails once. After that, every invocation
+// fails with the same error without calling the function, until Reset.
+type Fuse[T any] struct {
+	fun func(ctx context.Context) (T, error)
+	mu  sync.Mutex
+	err error
+}
+
+func NewFuse[T any](fun func(ctx context.Context) (T, error)) *Fuse[T] {
+	return &Fuse[T]{fun: fun}
+}
+
+func (fuse *Fuse[T]) Invoke(ctx context.Context) *Future[T] {
+	if err := fuse.Err(); err != nil {
+		return Err[T](ctx, err)
+	}
+	return New(ctx, func(ctx context.Context) (T, error) {
+		val, err := fuse.fun(ctx)
+		if err != nil {
+			fuse.mu.Lock()
+			if fuse.err == nil {
+				fuse.err = err
+			}
+			fuse.mu.Unlock()
+		}
+		return val, err
+	})
+}
+
+// Err returns the error that blew the fuse, or nil.
+func (fuse *Fuse[T]) Err() error {
+	fuse.mu.Lock()
+	defer fuse.mu.Unlock()
+	return fuse.err
+}
+
+func (fuse *Fuse[T]) Reset() {
+	fuse.mu.Lock()
+	defer fuse.mu.Unlock()
+	fuse.err = nil
+}
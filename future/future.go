@@ -2,6 +2,10 @@ package future
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"time"
 )
 
 type State int
@@ -12,74 +16,150 @@ const (
 	StateError
 )
 
+func (s State) String() string {
+	switch s {
+	case StatePending:
+		return "pending"
+	case StateDone:
+		return "done"
+	case StateError:
+		return "error"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
 type Future[T any] struct {
-	ctx     context.Context
-	val     T
-	err     error
-	state   State
-	stateCh chan State
+	ctx       context.Context
+	name      string
+	createdAt time.Time
+	val       T
+	err       error
+	state     State
+	done      chan struct{}
 }
 
+// settledCh is the done channel shared by futures that are created settled.
+var settledCh = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
 func Ok[T any](ctx context.Context, val T) *Future[T] {
 	return &Future[T]{
-		ctx:   ctx,
-		val:   val,
-		state: StateDone,
+		ctx:       ctx,
+		createdAt: time.Now(),
+		val:       val,
+		state:     StateDone,
+		done:      settledCh,
 	}
 }
 
 func Err[T any](ctx context.Context, err error) *Future[T] {
 	return &Future[T]{
-		ctx:   ctx,
-		err:   err,
-		state: StateError,
+		ctx:       ctx,
+		createdAt: time.Now(),
+		err:       err,
+		state:     StateError,
+		done:      settledCh,
 	}
 }
 
 func New[T any](ctx context.Context, fun func(ctx context.Context) (T, error)) *Future[T] {
+	return NewWithOptions(ctx, fun)
+}
+
+func NewWithOptions[T any](ctx context.Context, fun func(ctx context.Context) (T, error), opts ...Option[T]) *Future[T] {
+	o := newOptions(opts)
+	if o.detached {
+		ctx = context.WithoutCancel(ctx)
+	}
+	if o.recover {
+		fun = withRecover(fun)
+	}
+	if o.deadline {
+		fun = withDeadline(fun)
+	}
+	if o.maxRetries > 0 {
+		fun = withRetries(fun, o.maxRetries, o.retryDelay)
+	}
+	if o.hasFallback {
+		fun = withFallback(fun, o.fallback)
+	}
+	if o.timeout != nil {
+		fun = withTimeoutError(fun, o.timeout)
+	}
+	if o.debug {
+		var pcs [1]uintptr
+		runtime.Callers(2, pcs[:])
+		frame, _ := runtime.CallersFrames(pcs[:]).Next()
+		fun = withDebug(fun, o.name, frame.File, frame.Line)
+	}
+	if o.logger != nil {
+		fun = withLogger(fun, o.logger)
+	}
 	f := &Future[T]{
-		ctx:     ctx,
-		state:   StatePending,
-		stateCh: make(chan State),
+		ctx:       ctx,
+		name:      o.name,
+		createdAt: time.Now(),
+		state:     StatePending,
+		done:      make(chan struct{}),
 	}
-	go func() {
-		val, err := fun(f.ctx)
+	runCtx := injectContext(ctx)
+	o.executor.Go(func() {
+		val, err := fun(runCtx)
 		if err != nil {
-			f.err = err
+			f.err = settledError(err)
 			f.state = StateError
 		} else {
 			f.val = val
 			f.state = StateDone
 		}
-		f.stateCh <- f.state
-	}()
+		close(f.done)
+	})
 	return f
 }
 
-func (f *Future[T]) TryGet(ctx context.Context) (T, error) {
-	if f.state == StateDone {
-		return f.val, nil
-	}
-	if f.state == StateError {
-		var defaultT T
-		return defaultT, f.err
+// Background is like New, but computes with context.Background().
+// The computation is never canceled, so it is only suitable for work that
+// outlives any single request, such as periodic refreshes. Do not use it
+// for request-scoped work.
+func Background[T any](fun func(ctx context.Context) (T, error)) *Future[T] {
+	return New(context.Background(), fun)
+}
+
+// Context returns the context the future was created with.
+func (f *Future[T]) Context() context.Context {
+	return f.ctx
+}
+
+// State reports the state of f without blocking.
+func (f *Future[T]) State() State {
+	select {
+	case <-f.done:
+		return f.state
+	default:
+		return StatePending
 	}
+}
 
-	for {
+// TryGet waits for f to settle and returns its outcome, or ctx.Err() if ctx
+// is done first. Any number of goroutines may wait on the same future.
+func (f *Future[T]) TryGet(ctx context.Context) (T, error) {
+	if f.State() == StatePending {
 		select {
-		case state := <-f.stateCh:
-			if state == StateDone {
-				return f.val, nil
-			}
-			if state == StateError {
-				var defaultT T
-				return defaultT, f.err
-			}
+		case <-f.done:
 		case <-ctx.Done():
 			var defaultT T
 			return defaultT, ctx.Err()
 		}
 	}
+	if f.state == StateError {
+		var defaultT T
+		return defaultT, f.err
+	}
+	return f.val, nil
 }
 
 func (f *Future[T]) GetOr(ctx context.Context, fallback T) T {
@@ -106,18 +186,46 @@ func (f *Future[T]) MustGet(ctx context.Context) T {
 	return v
 }
 
+// GoString formats f as the Go expression that would create it once settled.
+func (f *Future[T]) GoString() string {
+	switch f.State() {
+	case StateDone:
+		return fmt.Sprintf("future.Ok(context.Background(), %#v)", f.val)
+	case StateError:
+		if f.err == nil {
+			return fmt.Sprintf("future.Err[%s](context.Background(), nil)", reflect.TypeFor[T]())
+		}
+		return fmt.Sprintf("future.Err[%s](context.Background(), errors.New(%q))", reflect.TypeFor[T](), f.err.Error())
+	default:
+		return "future.New(...)"
+	}
+}
+
 func Map[T any, U any](f *Future[T], fun func(ctx context.Context, val T) U) *Future[U] {
 	f2 := New(f.ctx, func(ctx context.Context) (U, error) {
 		val, err := f.TryGet(ctx)
 		if err != nil {
 			var defaultU U
-			return defaultU, err
+			return defaultU, passOn(ctx, err)
 		}
 		return fun(ctx, val), nil
 	})
 	return f2
 }
 
+// TryMap is like Map for functions that can fail.
+func TryMap[T any, U any](f *Future[T], fun func(ctx context.Context, val T) (U, error)) *Future[U] {
+	f2 := New(f.ctx, func(ctx context.Context) (U, error) {
+		val, err := f.TryGet(ctx)
+		if err != nil {
+			var defaultU U
+			return defaultU, passOn(ctx, err)
+		}
+		return fun(ctx, val)
+	})
+	return f2
+}
+
 func MapErr[T any](f *Future[T], fun func(ctx context.Context, val T) error) *Future[T] {
 	f2 := New(f.ctx, func(ctx context.Context) (T, error) {
 		val, err := f.TryGet(ctx)
@@ -129,23 +237,32 @@ func MapErr[T any](f *Future[T], fun func(ctx context.Context, val T) error) *Fu
 	return f2
 }
 
+// FlatMap chains a future-returning function onto f.
+// MapAsync is the same combinator under a name familiar to Go developers.
 func FlatMap[T any, U any](f *Future[T], fun func(ctx context.Context, val T) *Future[U]) *Future[U] {
 	f2 := New(f.ctx, func(ctx context.Context) (U, error) {
 		val, err := f.TryGet(ctx)
 		if err != nil {
 			var defaultU U
-			return defaultU, err
+			return defaultU, passOn(ctx, err)
 		}
-		return fun(ctx, val).TryGet(ctx)
+		mapped, err := fun(ctx, val).TryGet(ctx)
+		return mapped, passOn(ctx, err)
 	})
 	return f2
 }
 
+// MapAsync is an alias for FlatMap.
+func MapAsync[T any, U any](f *Future[T], fun func(ctx context.Context, val T) *Future[U]) *Future[U] {
+	return FlatMap(f, fun)
+}
+
 func FlatMapErr[T any, U any](f *Future[T], fun func(ctx context.Context, val T) *Future[U]) *Future[U] {
 	f2 := New(f.ctx, func(ctx context.Context) (U, error) {
 		val, err := f.TryGet(ctx)
 		if err != nil {
-			return fun(ctx, val).TryGet(ctx)
+			mapped, err := fun(ctx, val).TryGet(ctx)
+			return mapped, passOn(ctx, err)
 		}
 		var defaultU U
 		return defaultU, nil
@@ -153,42 +270,127 @@ func FlatMapErr[T any, U any](f *Future[T], fun func(ctx context.Context, val T)
 	return f2
 }
 
+// MapSettled calls fun with both the value and the error of f once it settles.
+func MapSettled[T any, U any](f *Future[T], fun func(ctx context.Context, val T, err error) (U, error)) *Future[U] {
+	f2 := New(f.ctx, func(ctx context.Context) (U, error) {
+		val, err := f.TryGet(ctx)
+		if err != nil {
+			var defaultT T
+			return fun(ctx, defaultT, err)
+		}
+		return fun(ctx, val, nil)
+	})
+	return f2
+}
+
+// FlatMapResult chains a future-returning function onto f, calling it with
+// both the value and the error of f once it settles.
+func FlatMapResult[T any, U any](f *Future[T], fun func(ctx context.Context, val T, err error) *Future[U]) *Future[U] {
+	return MapSettled(f, func(ctx context.Context, val T, err error) (U, error) {
+		mapped, err := fun(ctx, val, err).TryGet(ctx)
+		return mapped, passOn(ctx, err)
+	})
+}
+
 func IterPar[T any, U any](ctx context.Context, arr []T, fun func(ctx context.Context, val T) (U, error)) ([]U, error) {
-	futures := make([]*Future[U], len(arr))
-	for i, val := range arr {
-		futures[i] = New(ctx, func(ctx context.Context) (U, error) {
-			return fun(ctx, val)
-		})
-	}
-	return All(ctx, futures)
+	return IterParN(ctx, arr, int(defaultParallelism.Load()), fun)
 }
 
+// All waits for every future and returns their values in input order.
+// It stops waiting at the first failure. The failures already seen by then,
+// such as those of futures that had settled before, are returned together
+// as an *AggregateError; futures that fail later are not waited for.
 func All[T any](ctx context.Context, futures []*Future[T]) ([]T, error) {
-	doneCh := make(chan any)
-	errCh := make(chan error)
+	return AllWithProgress(ctx, futures, nil)
+}
+
+// AllVariadic is like All, but takes the futures as arguments.
+func AllVariadic[T any](ctx context.Context, futures ...*Future[T]) ([]T, error) {
+	return All(ctx, futures)
+}
+
+// AllWithProgress behaves like All, but calls progress from the collecting
+// goroutine each time one of the futures settles.
+func AllWithProgress[T any](ctx context.Context, futures []*Future[T], progress func(completed, total int)) ([]T, error) {
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, len(futures))
 	vals := make([]T, len(futures))
 
 	for i, f := range futures {
 		go func(f *Future[T], i int) {
-			val, err := f.TryGet(ctx)
-			if err != nil {
-				errCh <- err
-			} else {
+			val, err := f.TryGet(waitCtx)
+			if err == nil {
 				vals[i] = val
 			}
-			doneCh <- struct{}{}
+			errCh <- err
 		}(f, i)
 	}
 
+	var errs []error
+	aborted := false
+	completed := 0
 	for range futures {
-		select {
-		case <-doneCh:
-		case err := <-errCh:
-			return nil, err
-		case <-ctx.Done():
-			return nil, ctx.Err()
+		err := <-errCh
+		if err != nil && waitCtx.Err() != nil && err == waitCtx.Err() {
+			// The wait was aborted, either by the caller or by an earlier failure
+			aborted = true
+			continue
+		}
+		if progress != nil {
+			completed++
+			progress(completed, len(futures))
+		}
+		if err != nil {
+			errs = append(errs, err)
+			cancel()
 		}
 	}
 
+	if len(errs) > 0 {
+		return nil, newAggregateError(errs)
+	}
+	if aborted {
+		return nil, ctx.Err()
+	}
 	return vals, nil
 }
+
+// IterParWithProgress behaves like IterPar, but calls progress from the
+// calling goroutine each time fun returns for one of the elements.
+func IterParWithProgress[T any, U any](ctx context.Context, arr []T, fun func(ctx context.Context, val T) (U, error), progress func(completed, total int)) ([]U, error) {
+	if progress == nil {
+		return IterPar(ctx, arr, fun)
+	}
+
+	settled := make(chan struct{}, len(arr))
+	result := make(chan Result[[]U], 1)
+	go func() {
+		vals, err := IterPar(ctx, arr, func(ctx context.Context, val T) (U, error) {
+			defer func() { settled <- struct{}{} }()
+			return fun(ctx, val)
+		})
+		result <- Result[[]U]{Val: vals, Err: err}
+	}()
+
+	completed := 0
+	for {
+		select {
+		case <-settled:
+			completed++
+			progress(completed, len(arr))
+		case r := <-result:
+			// Report the elements that settled before IterPar returned
+			for {
+				select {
+				case <-settled:
+					completed++
+					progress(completed, len(arr))
+				default:
+					return r.Val, r.Err
+				}
+			}
+		}
+	}
+}
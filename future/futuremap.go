@@ -0,0 +1,68 @@
+package future
+
+import (
+	"context"
+	"sync"
+)
+
+// FutureMap is a goroutine-safe map whose values are computed concurrently.
+// The zero value is ready to use.
+type FutureMap[K comparable, V any] struct {
+	mu      sync.Mutex
+	futures map[K]*Future[V]
+}
+
+// Set starts computing the value for key, replacing any previous future.
+func (m *FutureMap[K, V]) Set(ctx context.Context, key K, fun func(ctx context.Context) (V, error)) {
+	f := New(ctx, fun)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.futures == nil {
+		m.futures = make(map[K]*Future[V])
+	}
+	m.futures[key] = f
+}
+
+func (m *FutureMap[K, V]) Get(key K) (*Future[V], bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	f, ok := m.futures[key]
+	return f, ok
+}
+
+func (m *FutureMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.futures, key)
+}
+
+// WaitAll waits for every future registered at the time of the call to
+// settle and returns their values, or all of their errors if any failed.
+func (m *FutureMap[K, V]) WaitAll(ctx context.Context) (map[K]V, error) {
+	m.mu.Lock()
+	keys := make([]K, 0, len(m.futures))
+	futures := make([]*Future[V], 0, len(m.futures))
+	for key, f := range m.futures {
+		keys = append(keys, key)
+		futures = append(futures, f)
+	}
+	m.mu.Unlock()
+
+	results := AllSettled(ctx, futures)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var errs []error
+	out := make(map[K]V, len(keys))
+	for i, key := range keys {
+		if results[i].Err != nil {
+			errs = append(errs, results[i].Err)
+			continue
+		}
+		out[key] = results[i].Val
+	}
+	if len(errs) > 0 {
+		return nil, newAggregateError(errs)
+	}
+	return out, nil
+}
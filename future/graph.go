@@ -0,0 +1,119 @@
+package future
+
+import (
+	"context"
+	"fmt"
+)
+
+// DepGraph runs a set of named computations, each one starting once all of
+// its dependencies have succeeded. Independent nodes run concurrently.
+type DepGraph[T any] struct {
+	nodes map[string]*depNode[T]
+	order []string
+}
+
+type depNode[T any] struct {
+	deps []string
+	fun  func(ctx context.Context) (T, error)
+}
+
+type depResult[T any] struct {
+	val  T
+	err  error
+	done chan struct{}
+}
+
+func NewDepGraph[T any]() *DepGraph[T] {
+	return &DepGraph[T]{
+		nodes: make(map[string]*depNode[T]),
+	}
+}
+
+// Add registers a node. Dependencies may refer to nodes that are added later,
+// but an edge that would close a cycle is rejected.
+func (g *DepGraph[T]) Add(name string, deps []string, fun func(ctx context.Context) (T, error)) error {
+	if _, ok := g.nodes[name]; ok {
+		return fmt.Errorf("future: node %q already exists", name)
+	}
+	for _, dep := range deps {
+		if g.reaches(dep, name, map[string]bool{}) {
+			return fmt.Errorf("future: dependency %q of %q creates a cycle", dep, name)
+		}
+	}
+	g.nodes[name] = &depNode[T]{deps: deps, fun: fun}
+	g.order = append(g.order, name)
+	return nil
+}
+
+func (g *DepGraph[T]) reaches(from string, to string, seen map[string]bool) bool {
+	if from == to {
+		return true
+	}
+	if seen[from] {
+		return false
+	}
+	seen[from] = true
+	node, ok := g.nodes[from]
+	if !ok {
+		return false
+	}
+	for _, dep := range node.deps {
+		if g.reaches(dep, to, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+// Run executes the graph and returns the value of every node.
+func (g *DepGraph[T]) Run(ctx context.Context) (map[string]T, error) {
+	for _, name := range g.order {
+		for _, dep := range g.nodes[name].deps {
+			if _, ok := g.nodes[dep]; !ok {
+				return nil, fmt.Errorf("future: node %q depends on unknown node %q", name, dep)
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(map[string]*depResult[T], len(g.order))
+	for _, name := range g.order {
+		results[name] = &depResult[T]{done: make(chan struct{})}
+	}
+
+	futures := make([]*Future[T], len(g.order))
+	for i, name := range g.order {
+		node := g.nodes[name]
+		res := results[name]
+		futures[i] = New(ctx, func(ctx context.Context) (T, error) {
+			defer close(res.done)
+			for _, dep := range node.deps {
+				depRes := results[dep]
+				select {
+				case <-depRes.done:
+				case <-ctx.Done():
+					res.err = ctx.Err()
+					return res.val, res.err
+				}
+				if depRes.err != nil {
+					res.err = fmt.Errorf("future: dependency %q of %q failed: %w", dep, name, depRes.err)
+					return res.val, res.err
+				}
+			}
+			res.val, res.err = node.fun(ctx)
+			return res.val, res.err
+		})
+	}
+
+	vals, err := All(ctx, futures)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]T, len(vals))
+	for i, name := range g.order {
+		out[name] = vals[i]
+	}
+	return out, nil
+}
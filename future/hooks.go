@@ -0,0 +1,96 @@
+package future
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+var errorTransform atomic.Pointer[func(err error) error]
+
+// SetGlobalErrorTransform sets a function that is applied to the error of
+// every future computed by New before it is stored. If the transform
+// returns nil the original error is kept. Passing nil removes the transform.
+func SetGlobalErrorTransform(fun func(err error) error) {
+	if fun == nil {
+		errorTransform.Store(nil)
+		return
+	}
+	errorTransform.Store(&fun)
+}
+
+// passedOnError marks an error that a combinator received from another
+// future. It was transformed when that future settled, so it is stored as is.
+type passedOnError struct {
+	err error
+}
+
+func (e passedOnError) Error() string {
+	return e.err.Error()
+}
+
+func (e passedOnError) Unwrap() error {
+	return e.err
+}
+
+// passOn marks err, received while awaiting other futures with ctx, as
+// already transformed. The error of ctx itself is left unmarked.
+func passOn(ctx context.Context, err error) error {
+	if err == nil || (ctx.Err() != nil && err == ctx.Err()) {
+		return err
+	}
+	return passedOnError{err: err}
+}
+
+// settledError returns the error to store for a future whose computation returned err.
+func settledError(err error) error {
+	if passed, ok := err.(passedOnError); ok {
+		return passed.err
+	}
+	return transformError(err)
+}
+
+func transformError(err error) error {
+	fun := errorTransform.Load()
+	if fun == nil {
+		return err
+	}
+	if transformed := (*fun)(err); transformed != nil {
+		return transformed
+	}
+	return err
+}
+
+// ContextPropagator derives the context a computation runs with from the
+// context passed to New, e.g. to carry trace spans across goroutines.
+type ContextPropagator interface {
+	Inject(parent context.Context) context.Context
+}
+
+type noopPropagator struct{}
+
+func (noopPropagator) Inject(parent context.Context) context.Context {
+	return parent
+}
+
+type propagatorHolder struct {
+	propagator ContextPropagator
+}
+
+var contextPropagator atomic.Pointer[propagatorHolder]
+
+// SetContextPropagator sets the propagator used by New. Inject is called in
+// the goroutine that creates the future. Passing nil restores the no-op default.
+func SetContextPropagator(p ContextPropagator) {
+	if p == nil {
+		p = noopPropagator{}
+	}
+	contextPropagator.Store(&propagatorHolder{propagator: p})
+}
+
+func injectContext(ctx context.Context) context.Context {
+	holder := contextPropagator.Load()
+	if holder == nil {
+		return ctx
+	}
+	return holder.propagator.Inject(ctx)
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Olian04/go-future/future"
+)
+
+// HTTPError is returned for responses with a non-2xx status code.
+type HTTPError struct {
+	StatusCode int
+}
+
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("future/http: unexpected status %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// NewFromHTTPResponse sends req with client and decodes the response with decode.
+// The response body is always closed once decode returns.
+// A nil client uses http.DefaultClient.
+func NewFromHTTPResponse[T any](ctx context.Context, client *http.Client, req *http.Request, decode func(*http.Response) (T, error)) *future.Future[T] {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return future.New(ctx, func(ctx context.Context) (T, error) {
+		var defaultT T
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return defaultT, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return defaultT, &HTTPError{StatusCode: resp.StatusCode}
+		}
+		return decode(resp)
+	})
+}
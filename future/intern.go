@@ -0,0 +1,58 @@
+package future
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type internKey struct {
+	key any
+	typ reflect.Type
+}
+
+type internEntry struct {
+	future any
+}
+
+var (
+	internMu  sync.Mutex
+	interned  = map[internKey]*internEntry{}
+	internTTL atomic.Int64
+)
+
+// SetInternTTL sets how long a settled future stays interned.
+// The default of 0 evicts futures as soon as they settle.
+func SetInternTTL(ttl time.Duration) {
+	internTTL.Store(int64(ttl))
+}
+
+// Intern returns the interned future for key, starting fun with ctx only if
+// there is none. Keys are scoped by T, so the same key may be interned for
+// different value types.
+func Intern[K comparable, T any](key K, fun func(ctx context.Context) (T, error), ctx context.Context) *Future[T] {
+	k := internKey{key: key, typ: reflect.TypeFor[T]()}
+
+	internMu.Lock()
+	defer internMu.Unlock()
+	if e, ok := interned[k]; ok {
+		return e.future.(*Future[T])
+	}
+
+	e := &internEntry{}
+	f := New(ctx, func(ctx context.Context) (T, error) {
+		defer time.AfterFunc(time.Duration(internTTL.Load()), func() {
+			internMu.Lock()
+			defer internMu.Unlock()
+			if interned[k] == e {
+				delete(interned, k)
+			}
+		})
+		return fun(ctx)
+	})
+	e.future = f
+	interned[k] = e
+	return f
+}
@@ -0,0 +1,163 @@
+package future
+
+import (
+	"context"
+	"iter"
+	"runtime"
+	"slices"
+	"sync/atomic"
+)
+
+var defaultParallelism atomic.Int64
+
+func init() {
+	defaultParallelism.Store(int64(runtime.GOMAXPROCS(0) * 2))
+}
+
+// SetDefaultParallelism caps the number of elements IterPar processes at once.
+// A value of zero or less removes the cap.
+func SetDefaultParallelism(n int) {
+	defaultParallelism.Store(int64(n))
+}
+
+// IterParN runs fun over arr using at most n concurrent workers.
+// A value of zero or less for n runs every element concurrently.
+func IterParN[T any, U any](ctx context.Context, arr []T, n int, fun func(ctx context.Context, val T) (U, error)) ([]U, error) {
+	if n <= 0 || n >= len(arr) {
+		futures := make([]*Future[U], len(arr))
+		for i, val := range arr {
+			futures[i] = New(ctx, func(ctx context.Context) (U, error) {
+				return fun(ctx, val)
+			})
+		}
+		return All(ctx, futures)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	indices := make(chan int)
+	go func() {
+		defer close(indices)
+		for i := range arr {
+			select {
+			case indices <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	vals := make([]U, len(arr))
+	workers := make([]*Future[struct{}], n)
+	for w := range workers {
+		workers[w] = New(ctx, func(ctx context.Context) (struct{}, error) {
+			for i := range indices {
+				val, err := fun(ctx, arr[i])
+				if err != nil {
+					return struct{}{}, err
+				}
+				vals[i] = val
+			}
+			return struct{}{}, ctx.Err()
+		})
+	}
+	if _, err := All(ctx, workers); err != nil {
+		return nil, err
+	}
+	return vals, nil
+}
+
+// IterParGrouped runs fun over arr in parallel and groups the results by the
+// key derived from each result value.
+func IterParGrouped[T any, K comparable](ctx context.Context, arr []T, fun func(ctx context.Context, val T) (T, error), key func(T) K) (map[K][]T, error) {
+	vals, err := IterPar(ctx, arr, fun)
+	if err != nil {
+		return nil, err
+	}
+	groups := make(map[K][]T)
+	for _, val := range vals {
+		k := key(val)
+		groups[k] = append(groups[k], val)
+	}
+	return groups, nil
+}
+
+// IterParMap runs fun over the entries of m in parallel and returns a map with
+// the same keys. Failures are reported as *MapKeyError[K].
+func IterParMap[K comparable, T any, U any](ctx context.Context, m map[K]T, fun func(ctx context.Context, key K, val T) (U, error)) (map[K]U, error) {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	vals, err := IterPar(ctx, keys, func(ctx context.Context, key K) (U, error) {
+		val, err := fun(ctx, key, m[key])
+		if err != nil {
+			return val, &MapKeyError[K]{Key: key, Err: err}
+		}
+		return val, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[K]U, len(keys))
+	for i, key := range keys {
+		out[key] = vals[i]
+	}
+	return out, nil
+}
+
+// IterParSeq is like IterPar for an iterator. The yielded elements are
+// buffered first, and the results are returned in yield order.
+func IterParSeq[T any, U any](ctx context.Context, seq iter.Seq[T], fun func(ctx context.Context, val T) (U, error)) ([]U, error) {
+	return IterPar(ctx, slices.Collect(seq), fun)
+}
+
+// RepeatN calls fun n times concurrently with the indices 0 to n-1 and
+// resolves with the results in index order.
+func RepeatN[T any](ctx context.Context, n int, fun func(ctx context.Context, i int) (T, error)) *Future[[]T] {
+	return New(ctx, func(ctx context.Context) ([]T, error) {
+		indices := make([]int, max(n, 0))
+		for i := range indices {
+			indices[i] = i
+		}
+		vals, err := IterParN(ctx, indices, 0, fun)
+		return vals, passOn(ctx, err)
+	})
+}
+
+// IterParErrors runs fun over every element of arr in parallel without
+// stopping at the first error, and splits the outcomes into successes and
+// failures, both ordered by index.
+func IterParErrors[T any, U any](ctx context.Context, arr []T, fun func(ctx context.Context, val T) (U, error)) (successes []IndexedResult[U], failures []IndexedError[T]) {
+	outcomes, err := IterPar(ctx, arr, func(ctx context.Context, val T) (SettledResult[U], error) {
+		out, err := fun(ctx, val)
+		return SettledResult[U]{Val: out, Err: err}, nil
+	})
+	for i, input := range arr {
+		if err != nil {
+			failures = append(failures, IndexedError[T]{Index: i, Input: input, Err: err})
+			continue
+		}
+		if outcomes[i].Err != nil {
+			failures = append(failures, IndexedError[T]{Index: i, Input: input, Err: outcomes[i].Err})
+			continue
+		}
+		successes = append(successes, IndexedResult[U]{Index: i, Val: outcomes[i].Val})
+	}
+	return successes, failures
+}
+
+// IterParWithContext is like IterPar, but calls fun with the context returned
+// by ctxFn for each element.
+func IterParWithContext[T any, U any](ctx context.Context, arr []T, ctxFn func(ctx context.Context, val T) context.Context, fun func(ctx context.Context, val T) (U, error)) ([]U, error) {
+	return IterPar(ctx, arr, func(ctx context.Context, val T) (U, error) {
+		return fun(ctxFn(ctx, val), val)
+	})
+}
+
+// ConcurrentMap is IterPar under a name that describes the operation rather
+// than the iteration pattern.
+func ConcurrentMap[T any, U any](ctx context.Context, arr []T, fun func(ctx context.Context, val T) (U, error)) ([]U, error) {
+	return IterPar(ctx, arr, fun)
+}
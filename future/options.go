@@ -0,0 +1,219 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Executor schedules the computation of a future.
+type Executor interface {
+	Go(fun func())
+}
+
+type goExecutor struct{}
+
+func (goExecutor) Go(fun func()) {
+	go fun()
+}
+
+// Option configures a future created by NewWithOptions.
+type Option[T any] func(*options[T])
+
+type options[T any] struct {
+	executor Executor
+	name     string
+	debug    bool
+	recover  bool
+	detached bool
+	deadline bool
+	timeout  error
+	logger   func(state State, val T, err error)
+
+	maxRetries int
+	retryDelay time.Duration
+
+	fallback    T
+	hasFallback bool
+}
+
+func newOptions[T any](opts []Option[T]) *options[T] {
+	o := &options[T]{
+		executor: goExecutor{},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithExecutor runs the computation through e instead of a new goroutine.
+func WithExecutor[T any](e Executor) Option[T] {
+	return func(o *options[T]) {
+		o.executor = e
+	}
+}
+
+// WithName names the future, which is included in the errors reported through WithDebug.
+func WithName[T any](name string) Option[T] {
+	return func(o *options[T]) {
+		o.name = name
+	}
+}
+
+// WithDebug wraps the error of the future in a *FutureError recording where
+// NewWithOptions was called.
+func WithDebug[T any]() Option[T] {
+	return func(o *options[T]) {
+		o.debug = true
+	}
+}
+
+// WithRecover turns a panic in the computation into a *PanicError.
+func WithRecover[T any]() Option[T] {
+	return func(o *options[T]) {
+		o.recover = true
+	}
+}
+
+// WithDetached runs the computation with a context that is not canceled
+// along with the context passed to NewWithOptions, so it can complete even if
+// the caller gives up. The values of that context are kept.
+func WithDetached[T any]() Option[T] {
+	return func(o *options[T]) {
+		o.detached = true
+	}
+}
+
+// WithDeadlinePropagation settles the future with context.DeadlineExceeded
+// once the deadline of its context passes, even if the computation has not
+// returned yet. The computation itself keeps running until it returns.
+func WithDeadlinePropagation[T any]() Option[T] {
+	return func(o *options[T]) {
+		o.deadline = true
+	}
+}
+
+// WithTimeoutError fails the future with err instead of context.DeadlineExceeded.
+func WithTimeoutError[T any](err error) Option[T] {
+	return func(o *options[T]) {
+		o.timeout = err
+	}
+}
+
+// WithLogger calls logger with the outcome of the computation before the future settles.
+func WithLogger[T any](logger func(state State, val T, err error)) Option[T] {
+	return func(o *options[T]) {
+		o.logger = logger
+	}
+}
+
+// WithMaxRetries runs the computation up to n more times while it fails.
+func WithMaxRetries[T any](n int) Option[T] {
+	return func(o *options[T]) {
+		o.maxRetries = n
+	}
+}
+
+// WithRetryDelay waits d between the retries enabled by WithMaxRetries.
+func WithRetryDelay[T any](d time.Duration) Option[T] {
+	return func(o *options[T]) {
+		o.retryDelay = d
+	}
+}
+
+// WithFallback settles the future with val instead of failing.
+func WithFallback[T any](val T) Option[T] {
+	return func(o *options[T]) {
+		o.fallback = val
+		o.hasFallback = true
+	}
+}
+
+func withRecover[T any](fun func(ctx context.Context) (T, error)) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (val T, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				var defaultT T
+				val, err = defaultT, &PanicError{Value: r}
+			}
+		}()
+		return fun(ctx)
+	}
+}
+
+func withDeadline[T any](fun func(ctx context.Context) (T, error)) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return fun(ctx)
+		}
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		defer cancel()
+
+		done := make(chan Result[T], 1)
+		go func() {
+			val, err := fun(ctx)
+			done <- Result[T]{Val: val, Err: err}
+		}()
+		select {
+		case r := <-done:
+			return r.Val, r.Err
+		case <-ctx.Done():
+			var defaultT T
+			return defaultT, ctx.Err()
+		}
+	}
+}
+
+func withRetries[T any](fun func(ctx context.Context) (T, error), retries int, delay time.Duration) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		return retry(ctx, retries+1, func(int, error) bool {
+			return true
+		}, func(int) time.Duration {
+			return delay
+		}, fun)
+	}
+}
+
+func withFallback[T any](fun func(ctx context.Context) (T, error), fallback T) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		val, err := fun(ctx)
+		if err != nil {
+			return fallback, nil
+		}
+		return val, nil
+	}
+}
+
+func withTimeoutError[T any](fun func(ctx context.Context) (T, error), timeoutErr error) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		val, err := fun(ctx)
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = timeoutErr
+		}
+		return val, err
+	}
+}
+
+func withDebug[T any](fun func(ctx context.Context) (T, error), name string, file string, line int) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		val, err := fun(ctx)
+		if err != nil {
+			err = &FutureError{Cause: err, FutureName: name, File: file, Line: line}
+		}
+		return val, err
+	}
+}
+
+func withLogger[T any](fun func(ctx context.Context) (T, error), logger func(state State, val T, err error)) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		val, err := fun(ctx)
+		if err != nil {
+			logger(StateError, val, err)
+		} else {
+			logger(StateDone, val, nil)
+		}
+		return val, err
+	}
+}
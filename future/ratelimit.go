@@ -0,0 +1,81 @@
+package future
+
+import (
+	"cmp"
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter invokes a function at most rps times per second using a token
+// bucket holding a single token.
+type RateLimiter[T any] struct {
+	ctx    context.Context
+	rps    float64
+	fun    func(ctx context.Context) (T, error)
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func NewRateLimiter[T any](ctx context.Context, rps float64, fun func(ctx context.Context) (T, error)) *RateLimiter[T] {
+	if rps <= 0 {
+		panic("future: rate limit must be positive")
+	}
+	return &RateLimiter[T]{
+		ctx:    ctx,
+		rps:    rps,
+		fun:    fun,
+		tokens: 1,
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long to wait before it becomes valid.
+func (rl *RateLimiter[T]) reserve() time.Duration {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	now := time.Now()
+	rl.tokens = min(1, rl.tokens+now.Sub(rl.last).Seconds()*rl.rps)
+	rl.last = now
+	rl.tokens--
+	if rl.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-rl.tokens / rl.rps * float64(time.Second))
+}
+
+// release gives back a token taken by reserve that was never used.
+func (rl *RateLimiter[T]) release() {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.tokens++
+}
+
+// Invoke returns a future that calls the function once a token is available.
+// It fails if either ctx or the limiter's context is done while waiting,
+// in which case the token is given back.
+func (rl *RateLimiter[T]) Invoke(ctx context.Context) *Future[T] {
+	if err := cmp.Or(ctx.Err(), rl.ctx.Err()); err != nil {
+		return New(ctx, func(ctx context.Context) (T, error) {
+			var defaultT T
+			return defaultT, err
+		})
+	}
+	wait := rl.reserve()
+	return New(ctx, func(ctx context.Context) (T, error) {
+		var defaultT T
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			rl.release()
+			return defaultT, ctx.Err()
+		case <-rl.ctx.Done():
+			rl.release()
+			return defaultT, rl.ctx.Err()
+		}
+		return rl.fun(ctx)
+	})
+}
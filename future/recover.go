@@ -0,0 +1,46 @@
+package future
+
+import (
+	"context"
+	"fmt"
+)
+
+// PanicError is the error of a future whose computation panicked.
+type PanicError struct {
+	Value any
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("future: panic: %v", e.Value)
+}
+
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Try is like New for functions that signal failure by panicking.
+// A panic in fun becomes a *PanicError.
+func Try[T any](ctx context.Context, fun func(ctx context.Context) T) *Future[T] {
+	return New(ctx, func(ctx context.Context) (val T, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = &PanicError{Value: r}
+			}
+		}()
+		return fun(ctx), nil
+	})
+}
+
+// Recover settles with the value of fun if f fails, and with the value of f otherwise.
+func Recover[T any](f *Future[T], fun func(ctx context.Context, err error) T) *Future[T] {
+	return New(f.ctx, func(ctx context.Context) (T, error) {
+		val, err := f.TryGet(ctx)
+		if err != nil {
+			return fun(ctx, err), nil
+		}
+		return val, nil
+	})
+}
@@ -0,0 +1,175 @@
+package future
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+type FutureInfo struct {
+	Name      string
+	State     State
+	CreatedAt time.Time
+	Duration  time.Duration
+	ElapsedMs int64
+	Err       string
+}
+
+// Describe returns a snapshot of the metadata of f.
+func Describe[T any](f *Future[T]) FutureInfo {
+	elapsed := time.Since(f.createdAt)
+	info := FutureInfo{
+		Name:      f.name,
+		State:     f.State(),
+		CreatedAt: f.createdAt,
+		Duration:  elapsed,
+		ElapsedMs: elapsed.Milliseconds(),
+	}
+	if info.State == StateError && f.err != nil {
+		info.Err = f.err.Error()
+	}
+	return info
+}
+
+// String summarizes the info on a single line.
+func (i FutureInfo) String() string {
+	name := i.Name
+	if name == "" {
+		name = "future"
+	}
+	s := fmt.Sprintf("%s: %s after %dms", name, i.State, i.ElapsedMs)
+	if i.Err != "" {
+		s += ": " + i.Err
+	}
+	return s
+}
+
+// Registry keeps track of futures until they settle.
+type Registry struct {
+	mu      sync.Mutex
+	entries []*registryEntry
+}
+
+type registryEntry struct {
+	name      string
+	createdAt time.Time
+	// settled is closed once err holds the outcome of the future.
+	settled chan struct{}
+	err     error
+}
+
+func (e *registryEntry) pending() bool {
+	select {
+	case <-e.settled:
+		return false
+	default:
+		return true
+	}
+}
+
+var defaultRegistry = NewRegistry()
+
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register tracks f in r until it settles. Futures that succeed are dropped
+// as soon as they settle; failed futures are kept until WaitAll reports them.
+func Register[T any](r *Registry, f *Future[T], name string) {
+	e := &registryEntry{
+		name:      name,
+		createdAt: time.Now(),
+		settled:   make(chan struct{}),
+	}
+	r.mu.Lock()
+	r.entries = append(r.entries, e)
+	r.mu.Unlock()
+
+	go func() {
+		<-f.done
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if f.state == StateError {
+			e.err = f.err
+		} else {
+			r.remove(func(other *registryEntry) bool { return other == e })
+		}
+		close(e.settled)
+	}()
+}
+
+// remove drops the entries for which drop returns true. r.mu must be held.
+func (r *Registry) remove(drop func(e *registryEntry) bool) {
+	r.entries = slices.DeleteFunc(r.entries, drop)
+}
+
+// Len returns the number of futures r holds: those still pending, and
+// those that failed and have not been reported by WaitAll yet.
+func (r *Registry) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.entries)
+}
+
+// NewTracked creates a future like New and registers it with the DefaultRegistry.
+func NewTracked[T any](ctx context.Context, name string, fun func(ctx context.Context) (T, error)) *Future[T] {
+	f := New(ctx, fun)
+	Register(defaultRegistry, f, name)
+	return f
+}
+
+// ActiveFutures returns the futures that are still pending.
+func (r *Registry) ActiveFutures() []FutureInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var infos []FutureInfo
+	for _, e := range r.entries {
+		if !e.pending() {
+			continue
+		}
+		elapsed := time.Since(e.createdAt)
+		infos = append(infos, FutureInfo{
+			Name:      e.name,
+			State:     StatePending,
+			CreatedAt: e.createdAt,
+			Duration:  elapsed,
+			ElapsedMs: elapsed.Milliseconds(),
+		})
+	}
+	return infos
+}
+
+// WaitAll waits for every registered future to settle and returns their
+// errors. Reported failures are dropped from the registry.
+func (r *Registry) WaitAll(ctx context.Context) error {
+	r.mu.Lock()
+	entries := slices.Clone(r.entries)
+	r.mu.Unlock()
+
+	for _, e := range entries {
+		select {
+		case <-e.settled:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var errs []error
+	for _, e := range entries {
+		if e.err != nil {
+			errs = append(errs, e.err)
+		}
+	}
+	r.remove(func(e *registryEntry) bool {
+		return slices.Contains(entries, e)
+	})
+	return newAggregateError(errs)
+}
@@ -0,0 +1,114 @@
+package future
+
+import (
+	"context"
+)
+
+// Attempt holds the outcome of a future as a single value.
+type Attempt[T any] struct {
+	Val T
+	Err error
+}
+
+func (a Attempt[T]) IsOk() bool {
+	return a.Err == nil
+}
+
+// Unwrap returns the value, and panics if the attempt failed.
+func (a Attempt[T]) Unwrap() T {
+	if a.Err != nil {
+		panic(a.Err)
+	}
+	return a.Val
+}
+
+func (a Attempt[T]) UnwrapOr(fallback T) T {
+	if a.Err != nil {
+		return fallback
+	}
+	return a.Val
+}
+
+func (a Attempt[T]) UnwrapErr() error {
+	return a.Err
+}
+
+func (f *Future[T]) GetAttempt(ctx context.Context) Attempt[T] {
+	val, err := f.TryGet(ctx)
+	return Attempt[T]{Val: val, Err: err}
+}
+
+// Result holds the outcome of a settled future.
+type Result[T any] struct {
+	Val T
+	Err error
+}
+
+// SettledResult is the name Result goes by in the collection combinators.
+type SettledResult[T any] = Result[T]
+
+// GetResult awaits f and returns its outcome as a single value.
+func GetResult[T any](ctx context.Context, f *Future[T]) Result[T] {
+	val, err := f.TryGet(ctx)
+	return Result[T]{Val: val, Err: err}
+}
+
+// MapResult applies fun to the value of a successful result.
+// A failed result is passed on with its error.
+func MapResult[T any, U any](r Result[T], fun func(T) Result[U]) Result[U] {
+	if r.Err != nil {
+		return Result[U]{Err: r.Err}
+	}
+	return fun(r.Val)
+}
+
+// IndexedResult is a successful result together with the index of its input.
+type IndexedResult[U any] struct {
+	Index int
+	Val   U
+}
+
+// IndexedError is a failed input together with its index and error.
+type IndexedError[T any] struct {
+	Index int
+	Input T
+	Err   error
+}
+
+// Optional is a value that may be absent.
+type Optional[T any] struct {
+	val T
+	ok  bool
+}
+
+func Some[T any](val T) Optional[T] {
+	return Optional[T]{val: val, ok: true}
+}
+
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+func (o Optional[T]) IsSome() bool {
+	return o.ok
+}
+
+func (o Optional[T]) Get() (T, bool) {
+	return o.val, o.ok
+}
+
+func (o Optional[T]) GetOr(fallback T) T {
+	if !o.ok {
+		return fallback
+	}
+	return o.val
+}
+
+// GetOption returns the value of f, or None if f failed.
+func (f *Future[T]) GetOption(ctx context.Context) Optional[T] {
+	val, err := f.TryGet(ctx)
+	if err != nil {
+		return None[T]()
+	}
+	return Some(val)
+}
@@ -0,0 +1,70 @@
+package future
+
+import (
+	"context"
+	"time"
+)
+
+// Retry runs fun up to attempts times until it succeeds.
+// If every attempt fails, the future settles with the last error.
+func Retry[T any](ctx context.Context, attempts int, fun func(ctx context.Context) (T, error)) *Future[T] {
+	return RetryWithBackoff(ctx, attempts, nil, fun)
+}
+
+// RetryWithBackoff is like Retry, but waits backoff(attempt) after each failed
+// attempt before the next one. Attempts are numbered from 1.
+func RetryWithBackoff[T any](ctx context.Context, attempts int, backoff func(attempt int) time.Duration, fun func(ctx context.Context) (T, error)) *Future[T] {
+	return New(ctx, func(ctx context.Context) (T, error) {
+		return retry(ctx, attempts, func(int, error) bool {
+			return true
+		}, backoff, fun)
+	})
+}
+
+// RetryIf runs fun up to attempts times, retrying only while shouldRetry
+// approves the error of the attempt that just failed. Attempts are numbered
+// from 1. The future settles with the last result.
+func RetryIf[T any](ctx context.Context, attempts int, shouldRetry func(attempt int, err error) bool, fun func(ctx context.Context) (T, error)) *Future[T] {
+	return New(ctx, func(ctx context.Context) (T, error) {
+		return retry(ctx, attempts, shouldRetry, nil, fun)
+	})
+}
+
+// SpreadLoad calls the endpoint chosen by pick, moving on to the next endpoint
+// in order whenever one fails, until one succeeds or every endpoint has been
+// tried once. The future settles with the last result. An index out of range
+// from pick wraps around.
+func SpreadLoad[T any](ctx context.Context, endpoints []func(ctx context.Context) (T, error), pick func([]func(ctx context.Context) (T, error)) int) *Future[T] {
+	if len(endpoints) == 0 {
+		return Err[T](ctx, ErrEmptySlice)
+	}
+	n := len(endpoints)
+	start := (pick(endpoints)%n + n) % n
+	attempt := 0
+	return New(ctx, func(ctx context.Context) (T, error) {
+		return retry(ctx, n, func(int, error) bool { return true }, nil, func(ctx context.Context) (T, error) {
+			endpoint := endpoints[(start+attempt)%n]
+			attempt++
+			return endpoint(ctx)
+		})
+	})
+}
+
+func retry[T any](ctx context.Context, attempts int, shouldRetry func(attempt int, err error) bool, delay func(attempt int) time.Duration, fun func(ctx context.Context) (T, error)) (T, error) {
+	for attempt := 1; ; attempt++ {
+		val, err := fun(ctx)
+		if err == nil || attempt >= attempts || ctx.Err() != nil || !shouldRetry(attempt, err) {
+			return val, err
+		}
+		if delay == nil {
+			continue
+		}
+		timer := time.NewTimer(delay(attempt))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return val, err
+		}
+	}
+}
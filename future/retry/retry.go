@@ -0,0 +1,38 @@
+package retry
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// BackoffPolicy returns how long to wait after the given failed attempt.
+// Attempts are numbered from 1.
+type BackoffPolicy func(attempt int) time.Duration
+
+// Constant waits d after every attempt.
+func Constant(d time.Duration) BackoffPolicy {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// Exponential waits base after the first attempt and doubles the delay after each one that follows.
+func Exponential(base time.Duration) BackoffPolicy {
+	return func(attempt int) time.Duration {
+		return base << (attempt - 1)
+	}
+}
+
+// WithJitter adds a random delay of up to factor times the delay of policy,
+// so that futures failing together do not retry in lockstep.
+// factor must be within [0, 1].
+func WithJitter(policy BackoffPolicy, factor float64) (BackoffPolicy, error) {
+	if factor < 0 || factor > 1 {
+		return nil, fmt.Errorf("future/retry: jitter factor %v is outside [0, 1]", factor)
+	}
+	return func(attempt int) time.Duration {
+		d := policy(attempt)
+		return d + time.Duration(rand.Float64()*factor*float64(d))
+	}, nil
+}
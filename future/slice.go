@@ -0,0 +1,187 @@
+package future
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Head resolves with the first element of the slice, or fails with ErrEmptySlice.
+func Head[T any](f *Future[[]T]) *Future[T] {
+	return New(f.ctx, func(ctx context.Context) (T, error) {
+		vals, err := f.TryGet(ctx)
+		err = passOn(ctx, err)
+		if err == nil && len(vals) == 0 {
+			err = ErrEmptySlice
+		}
+		if err != nil {
+			var defaultT T
+			return defaultT, err
+		}
+		return vals[0], nil
+	})
+}
+
+// Tail resolves with every element but the first, or fails with ErrEmptySlice.
+func Tail[T any](f *Future[[]T]) *Future[[]T] {
+	return New(f.ctx, func(ctx context.Context) ([]T, error) {
+		vals, err := f.TryGet(ctx)
+		err = passOn(ctx, err)
+		if err == nil && len(vals) == 0 {
+			err = ErrEmptySlice
+		}
+		if err != nil {
+			return nil, err
+		}
+		return vals[1:], nil
+	})
+}
+
+// FlattenSingle resolves with the only element of the slice, or fails with
+// ErrExpectedExactlyOne if the slice does not hold exactly one element.
+func FlattenSingle[T any](f *Future[[]T]) *Future[T] {
+	return New(f.ctx, func(ctx context.Context) (T, error) {
+		vals, err := f.TryGet(ctx)
+		err = passOn(ctx, err)
+		if err == nil && len(vals) != 1 {
+			err = ErrExpectedExactlyOne
+		}
+		if err != nil {
+			var defaultT T
+			return defaultT, err
+		}
+		return vals[0], nil
+	})
+}
+
+// FutureSlice is a collection of futures with the collection combinators as methods.
+type FutureSlice[T any] []*Future[T]
+
+func NewFutureSlice[T any](futures ...*Future[T]) FutureSlice[T] {
+	return FutureSlice[T](futures)
+}
+
+func (fs FutureSlice[T]) All(ctx context.Context) ([]T, error) {
+	return All(ctx, fs)
+}
+
+func (fs FutureSlice[T]) Any(ctx context.Context) (T, error) {
+	return Any(ctx, fs)
+}
+
+func (fs FutureSlice[T]) AllSettled(ctx context.Context) []SettledResult[T] {
+	return AllSettled(ctx, fs)
+}
+
+// Append returns a new FutureSlice with f added at the end.
+func (fs FutureSlice[T]) Append(f *Future[T]) FutureSlice[T] {
+	return append(fs[:len(fs):len(fs)], f)
+}
+
+func (fs FutureSlice[T]) Len() int {
+	return len(fs)
+}
+
+// Shuffle returns a new FutureSlice with the futures in a uniformly random order.
+func (fs FutureSlice[T]) Shuffle() FutureSlice[T] {
+	shuffled := slices.Clone(fs)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+// Parallel is a builder for a set of futures awaited together.
+type Parallel[T any] struct {
+	futures FutureSlice[T]
+}
+
+func NewParallel[T any]() *Parallel[T] {
+	return &Parallel[T]{}
+}
+
+func (p *Parallel[T]) Add(f *Future[T]) *Parallel[T] {
+	p.futures = append(p.futures, f)
+	return p
+}
+
+func (p *Parallel[T]) All(ctx context.Context) ([]T, error) {
+	return p.futures.All(ctx)
+}
+
+func (p *Parallel[T]) Any(ctx context.Context) (T, error) {
+	return p.futures.Any(ctx)
+}
+
+func (p *Parallel[T]) AllSettled(ctx context.Context) []SettledResult[T] {
+	return p.futures.AllSettled(ctx)
+}
+
+// Concurrent is a builder for a set of futures of different types awaited together.
+type Concurrent struct {
+	waits  []func(ctx context.Context) error
+	waited atomic.Bool
+}
+
+// ConcurrentHandle gives access to the value of a future added to a Concurrent.
+type ConcurrentHandle[T any] struct {
+	c   *Concurrent
+	val T
+}
+
+// AddConcurrent adds f to c. Go methods cannot declare type parameters, so
+// this is a function rather than a method on Concurrent.
+func AddConcurrent[T any](c *Concurrent, f *Future[T]) *ConcurrentHandle[T] {
+	h := &ConcurrentHandle[T]{c: c}
+	c.waits = append(c.waits, func(ctx context.Context) error {
+		val, err := f.TryGet(ctx)
+		if err == nil {
+			h.val = val
+		}
+		return err
+	})
+	return h
+}
+
+// Wait waits for every added future like All does. Once Wait returns, no
+// handle is written to anymore.
+func (c *Concurrent) Wait(ctx context.Context) error {
+	waitCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var errs []error
+	var wg sync.WaitGroup
+	for _, wait := range c.waits {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := wait(waitCtx)
+			if err == nil || (waitCtx.Err() != nil && err == waitCtx.Err()) {
+				return
+			}
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+			cancel()
+		}()
+	}
+	wg.Wait()
+	c.waited.Store(true)
+
+	if len(errs) > 0 {
+		return newAggregateError(errs)
+	}
+	return ctx.Err()
+}
+
+// Value returns the value of the future, or the zero value if it failed.
+// It panics if Wait has not returned yet.
+func (h *ConcurrentHandle[T]) Value() T {
+	if !h.c.waited.Load() {
+		panic("future: Value called before Wait")
+	}
+	return h.val
+}
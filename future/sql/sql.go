@@ -0,0 +1,33 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Olian04/go-future/future"
+)
+
+// NewFromQuery runs query on db and decodes the rows with scan.
+// The rows are always closed once scan returns.
+func NewFromQuery[T any](ctx context.Context, db *sql.DB, query string, args []any, scan func(*sql.Rows) (T, error)) *future.Future[T] {
+	return future.New(ctx, func(ctx context.Context) (T, error) {
+		rows, err := db.QueryContext(ctx, query, args...)
+		if err != nil {
+			var defaultT T
+			return defaultT, err
+		}
+		defer rows.Close()
+		val, err := scan(rows)
+		if err != nil {
+			return val, err
+		}
+		return val, rows.Err()
+	})
+}
+
+// NewFromRow runs a query expected to return at most one row and decodes it with scan.
+func NewFromRow[T any](ctx context.Context, db *sql.DB, query string, args []any, scan func(*sql.Row) (T, error)) *future.Future[T] {
+	return future.New(ctx, func(ctx context.Context) (T, error) {
+		return scan(db.QueryRowContext(ctx, query, args...))
+	})
+}
@@ -0,0 +1,198 @@
+package future
+
+import (
+	"context"
+	"sync"
+)
+
+// Stream is a future that produces zero or more values.
+type Stream[T any] struct {
+	mu     sync.Mutex
+	next   func(ctx context.Context) (T, bool, error)
+	cancel context.CancelFunc
+	done   bool
+	err    error
+}
+
+func newStream[T any](cancel context.CancelFunc, next func(ctx context.Context) (T, bool, error)) *Stream[T] {
+	return &Stream[T]{
+		next:   next,
+		cancel: cancel,
+	}
+}
+
+// NewStream runs fun in a new goroutine. Each call to emit blocks until the
+// value is consumed by Next, and fails once ctx is done.
+func NewStream[T any](ctx context.Context, fun func(ctx context.Context, emit func(T) error) error) *Stream[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	values := make(chan T)
+	finished := make(chan struct{})
+	var err error
+	go func() {
+		defer cancel()
+		defer close(finished)
+		err = fun(ctx, func(val T) error {
+			select {
+			case values <- val:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return newStream(cancel, func(ctx context.Context) (T, bool, error) {
+		select {
+		case val := <-values:
+			return val, true, nil
+		case <-finished:
+			var defaultT T
+			return defaultT, false, err
+		case <-ctx.Done():
+			var defaultT T
+			return defaultT, false, ctx.Err()
+		}
+	})
+}
+
+// Next returns the next value of the stream. At the end of the stream it
+// returns false, together with the error that terminated the stream, if any.
+// A done ctx only aborts the current call.
+func (s *Stream[T]) Next(ctx context.Context) (T, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var defaultT T
+	if s.done {
+		return defaultT, false, s.err
+	}
+	if err := ctx.Err(); err != nil {
+		return defaultT, false, err
+	}
+	val, ok, err := s.next(ctx)
+	if !ok && (err == nil || err != ctx.Err()) {
+		s.done = true
+		s.err = err
+	}
+	return val, ok, err
+}
+
+// StreamMap lazily applies fun to each value of s as it is consumed.
+// An error from fun terminates the resulting stream and stops s.
+func StreamMap[T any, U any](s *Stream[T], fun func(ctx context.Context, val T) (U, error)) *Stream[U] {
+	return newStream(s.cancel, func(ctx context.Context) (U, bool, error) {
+		var defaultU U
+		val, ok, err := s.Next(ctx)
+		if !ok {
+			return defaultU, false, err
+		}
+		mapped, err := fun(ctx, val)
+		if err != nil {
+			s.cancel()
+			return defaultU, false, err
+		}
+		return mapped, true, nil
+	})
+}
+
+// StreamFilter forwards only the values of s that satisfy predicate.
+// The predicate runs in the caller of Next.
+func StreamFilter[T any](s *Stream[T], predicate func(ctx context.Context, val T) bool) *Stream[T] {
+	return StreamFilterErr(s, func(ctx context.Context, val T) (bool, error) {
+		return predicate(ctx, val), nil
+	})
+}
+
+// StreamFilterErr is like StreamFilter for predicates that can fail.
+// An error from predicate terminates the resulting stream and stops s.
+func StreamFilterErr[T any](s *Stream[T], predicate func(ctx context.Context, val T) (bool, error)) *Stream[T] {
+	return newStream(s.cancel, func(ctx context.Context) (T, bool, error) {
+		for {
+			val, ok, err := s.Next(ctx)
+			if !ok {
+				return val, false, err
+			}
+			keep, err := predicate(ctx, val)
+			if err != nil {
+				s.cancel()
+				var defaultT T
+				return defaultT, false, err
+			}
+			if keep {
+				return val, true, nil
+			}
+		}
+	})
+}
+
+// StreamTake yields at most n values of s, then stops s.
+func StreamTake[T any](s *Stream[T], n int) *Stream[T] {
+	taken := 0
+	return newStream(s.cancel, func(ctx context.Context) (T, bool, error) {
+		if taken >= n {
+			s.cancel()
+			var defaultT T
+			return defaultT, false, nil
+		}
+		val, ok, err := s.Next(ctx)
+		if ok {
+			taken++
+			if taken == n {
+				s.cancel()
+			}
+		}
+		return val, ok, err
+	})
+}
+
+// StreamCollect reads s to the end and resolves with every value.
+func StreamCollect[T any](ctx context.Context, s *Stream[T]) *Future[[]T] {
+	return New(ctx, func(ctx context.Context) ([]T, error) {
+		var vals []T
+		for {
+			val, ok, err := s.Next(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return vals, nil
+			}
+			vals = append(vals, val)
+		}
+	})
+}
+
+// StreamMerge interleaves the values of streams as they arrive. The merged
+// stream ends once every input has ended, or as soon as one of them fails,
+// in which case the remaining inputs are stopped.
+func StreamMerge[T any](ctx context.Context, streams ...*Stream[T]) *Stream[T] {
+	return NewStream(ctx, func(ctx context.Context, emit func(T) error) error {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		errCh := make(chan error, len(streams))
+		for _, s := range streams {
+			go func() {
+				for {
+					val, ok, err := s.Next(ctx)
+					if !ok {
+						errCh <- err
+						return
+					}
+					if err := emit(val); err != nil {
+						errCh <- err
+						return
+					}
+				}
+			}()
+		}
+
+		for range streams {
+			if err := <-errCh; err != nil {
+				for _, s := range streams {
+					s.cancel()
+				}
+				return err
+			}
+		}
+		return nil
+	})
+}
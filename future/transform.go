@@ -0,0 +1,141 @@
+package future
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// Checkpoint persists the value of f with save before settling.
+// If f fails, save is never called; if save fails, the checkpoint fails with its error.
+func Checkpoint[T any](f *Future[T], save func(ctx context.Context, val T) error) *Future[T] {
+	return New(f.ctx, func(ctx context.Context) (T, error) {
+		val, err := f.TryGet(ctx)
+		if err != nil {
+			return val, passOn(ctx, err)
+		}
+		if err := save(ctx, val); err != nil {
+			var defaultT T
+			return defaultT, err
+		}
+		return val, nil
+	})
+}
+
+// CheckpointedFuture is a checkpoint whose saved value can be rolled back.
+type CheckpointedFuture[T any] struct {
+	f        *Future[T]
+	rollback func(ctx context.Context, val T) error
+}
+
+// CheckpointWithRollback is like Checkpoint, but returns a handle that can undo
+// a successful save with rollback, such as when a later step of a saga fails.
+func CheckpointWithRollback[T any](f *Future[T], save func(ctx context.Context, val T) error, rollback func(ctx context.Context, val T) error) *CheckpointedFuture[T] {
+	return &CheckpointedFuture[T]{
+		f:        Checkpoint(f, save),
+		rollback: rollback,
+	}
+}
+
+func (c *CheckpointedFuture[T]) Result() *Future[T] {
+	return c.f
+}
+
+// Rollback awaits the checkpoint and calls rollback with the saved value.
+// If nothing was saved, Rollback does nothing and returns nil.
+func (c *CheckpointedFuture[T]) Rollback(ctx context.Context) error {
+	val, err := c.f.TryGet(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return nil
+	}
+	return c.rollback(ctx, val)
+}
+
+// WithBackground returns a future that waits for f without being canceled
+// along with the context of f. The values of that context are kept.
+// The computation of f has already started, so it only completes after its
+// context is canceled if f was created with WithDetached.
+func WithBackground[T any](f *Future[T]) *Future[T] {
+	return New(context.WithoutCancel(f.ctx), func(ctx context.Context) (T, error) {
+		val, err := f.TryGet(ctx)
+		return val, passOn(ctx, err)
+	})
+}
+
+// Inspect calls logger with the outcome of f before the returned future settles.
+func Inspect[T any](f *Future[T], logger func(state State, val T, err error)) *Future[T] {
+	return New(f.ctx, func(ctx context.Context) (T, error) {
+		val, err := f.TryGet(ctx)
+		if err != nil {
+			logger(StateError, val, err)
+		} else {
+			logger(StateDone, val, nil)
+		}
+		return val, passOn(ctx, err)
+	})
+}
+
+// Narrow asserts the value of f to type U, failing with a *TypeAssertionError
+// if the assertion does not hold.
+func Narrow[T any, U any](f *Future[T]) *Future[U] {
+	return New(f.ctx, func(ctx context.Context) (U, error) {
+		var defaultU U
+		val, err := f.TryGet(ctx)
+		if err != nil {
+			return defaultU, passOn(ctx, err)
+		}
+		narrowed, ok := any(val).(U)
+		if !ok {
+			return defaultU, &TypeAssertionError{
+				Actual:   fmt.Sprintf("%T", val),
+				Expected: reflect.TypeFor[U]().String(),
+			}
+		}
+		return narrowed, nil
+	})
+}
+
+// Widen converts f to a future of U, where T must be assignable to U, such
+// as a concrete type to an interface it implements. Go generics cannot
+// express this relation, so it is checked when Widen is called and a
+// mismatch panics. Settled futures are converted without a new goroutine.
+func Widen[T any, U any](f *Future[T]) *Future[U] {
+	from, to := reflect.TypeFor[T](), reflect.TypeFor[U]()
+	if !from.AssignableTo(to) {
+		panic(fmt.Sprintf("future: cannot widen %s to %s", from, to))
+	}
+	widen := func(val T) U {
+		var widened U
+		reflect.ValueOf(&widened).Elem().Set(reflect.ValueOf(&val).Elem())
+		return widened
+	}
+	switch f.State() {
+	case StateDone:
+		return Ok(f.ctx, widen(f.val))
+	case StateError:
+		return Err[U](f.ctx, f.err)
+	}
+	return New(f.ctx, func(ctx context.Context) (U, error) {
+		val, err := f.TryGet(ctx)
+		if err != nil {
+			var defaultU U
+			return defaultU, passOn(ctx, err)
+		}
+		return widen(val), nil
+	})
+}
+
+// Do calls fun with the value of f if it succeeds, and settles with the same
+// outcome as f.
+func Do[T any](f *Future[T], fun func(ctx context.Context, val T)) *Future[T] {
+	return New(f.ctx, func(ctx context.Context) (T, error) {
+		val, err := f.TryGet(ctx)
+		if err == nil {
+			fun(ctx, val)
+		}
+		return val, passOn(ctx, err)
+	})
+}
@@ -0,0 +1,44 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	futureretry "github.com/Olian04/go-future/future/retry"
+)
+
+func TestConstant(t *testing.T) {
+	policy := futureretry.Constant(time.Second)
+	for attempt := 1; attempt <= 3; attempt++ {
+		if d := policy(attempt); d != time.Second {
+			t.Fatalf("expected 1s, got %v", d)
+		}
+	}
+}
+
+func TestExponential(t *testing.T) {
+	policy := futureretry.Exponential(time.Millisecond)
+	for attempt, expected := range []time.Duration{time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond} {
+		if d := policy(attempt + 1); d != expected {
+			t.Fatalf("expected %v, got %v", expected, d)
+		}
+	}
+}
+
+func TestWithJitter(t *testing.T) {
+	policy, err := futureretry.WithJitter(futureretry.Constant(time.Second), 0.5)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for range 100 {
+		if d := policy(1); d < time.Second || d > 1500*time.Millisecond {
+			t.Fatalf("expected delay within [1s, 1.5s], got %v", d)
+		}
+	}
+
+	for _, factor := range []float64{-0.1, 1.1} {
+		if _, err := futureretry.WithJitter(futureretry.Constant(time.Second), factor); err == nil {
+			t.Fatalf("expected error for factor %v", factor)
+		}
+	}
+}
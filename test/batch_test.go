@@ -0,0 +1,95 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+	"github.com/Olian04/go-future/future/batch"
+)
+
+func TestWindow(t *testing.T) {
+	ctx := context.Background()
+	var mu sync.Mutex
+	var batches [][]int
+	w := batch.NewWindow(time.Hour, 2, func(ctx context.Context, keys []int) ([]string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		batches = append(batches, keys)
+		vals := make([]string, len(keys))
+		for i, key := range keys {
+			vals[i] = fmt.Sprint(key)
+		}
+		return vals, nil
+	})
+
+	a := w.Add(ctx, 1)
+	b := w.Add(ctx, 2)
+	vals, err := future.All(ctx, []*future.Future[string]{a, b})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if vals[0] != "1" || vals[1] != "2" {
+		t.Fatalf("expected [1 2], got %v", vals)
+	}
+
+	c := w.Add(ctx, 3)
+	w.Close()
+	val, err := c.TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "3" {
+		t.Fatalf("expected 3, got %v", val)
+	}
+	if len(batches) != 2 {
+		t.Fatalf("expected 2 batches, got %v", batches)
+	}
+
+	_, err = w.Add(ctx, 4).TryGet(ctx)
+	if !errors.Is(err, batch.ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+}
+
+func TestWindowExpires(t *testing.T) {
+	ctx := context.Background()
+	w := batch.NewWindow(time.Millisecond, 100, func(ctx context.Context, keys []int) ([]int, error) {
+		return nil, errors.New("error")
+	})
+	defer w.Close()
+
+	_, err := w.Add(ctx, 1).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestWindowWithoutDuration(t *testing.T) {
+	ctx := context.Background()
+	w := batch.NewWindow(0, 2, func(ctx context.Context, keys []int) ([]int, error) {
+		return keys, nil
+	})
+
+	vals, err := future.All(ctx, []*future.Future[int]{w.Add(ctx, 1), w.Add(ctx, 2)})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if vals[0] != 1 || vals[1] != 2 {
+		t.Fatalf("expected [1 2], got %v", vals)
+	}
+
+	f := w.Add(ctx, 3)
+	w.Close()
+	val, err := f.TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 3 {
+		t.Fatalf("expected 3, got %v", val)
+	}
+}
@@ -0,0 +1,129 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestSendOnSettle(t *testing.T) {
+	ctx := context.Background()
+	ch := make(chan int)
+	errCh := make(chan error)
+	future.SendOnSettle(future.New(ctx, func(ctx context.Context) (int, error) {
+		return 1, nil
+	}), ch, errCh)
+
+	select {
+	case val := <-ch:
+		if val != 1 {
+			t.Fatalf("expected 1, got %v", val)
+		}
+	case err := <-errCh:
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestSendOnSettleError(t *testing.T) {
+	ctx := context.Background()
+	ch := make(chan int)
+	errCh := make(chan error)
+	future.SendOnSettle(future.Err[int](ctx, errors.New("error")), ch, errCh)
+
+	select {
+	case val := <-ch:
+		t.Fatalf("expected error, got %v", val)
+	case err := <-errCh:
+		if err.Error() != "error" {
+			t.Fatalf("expected error, got %v", err)
+		}
+	}
+}
+
+func TestSendOnSettleCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	ch := make(chan int)
+	errCh := make(chan error)
+	future.SendOnSettle(future.New(ctx, func(ctx context.Context) (int, error) {
+		<-release
+		return 42, nil
+	}), ch, errCh)
+
+	cancel()
+	close(release)
+	select {
+	case val := <-ch:
+		if val != 42 {
+			t.Fatalf("expected 42, got %v", val)
+		}
+	case err := <-errCh:
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestWaitGroupFuture(t *testing.T) {
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	f := future.WaitGroupFuture(ctx, &wg)
+	wg.Done()
+
+	if _, err := f.TryGet(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestWaitGroupFutureCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(1)
+	defer wg.Done()
+	f := future.WaitGroupFuture(ctx, &wg)
+	cancel()
+
+	_, err := f.TryGet(context.Background())
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+}
+
+func TestNewFromChan(t *testing.T) {
+	ctx := context.Background()
+	ch := make(chan int, 1)
+	ch <- 1
+
+	val, err := future.NewFromChan(ctx, ch).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+}
+
+func TestNewFromChanClosed(t *testing.T) {
+	ctx := context.Background()
+	ch := make(chan int)
+	close(ch)
+
+	_, err := future.NewFromChan(ctx, ch).TryGet(ctx)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected unexpected EOF, got %v", err)
+	}
+}
+
+func TestNewFromChanCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ch := make(chan int)
+
+	_, err := future.NewFromChan(ctx, ch).TryGet(context.Background())
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+}
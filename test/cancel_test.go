@@ -0,0 +1,87 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func waitForCancel(ctx context.Context) (int, error) {
+	<-ctx.Done()
+	return 0, context.Cause(ctx)
+}
+
+func TestNewWithCancel(t *testing.T) {
+	ctx := context.Background()
+	f, cancel := future.NewWithCancel(ctx, waitForCancel)
+	cancel()
+
+	_, err := f.TryGet(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+}
+
+func TestCancelGroup(t *testing.T) {
+	ctx := context.Background()
+	g := future.NewCancelGroup()
+	f1, cancel1 := future.NewWithCancel(ctx, waitForCancel)
+	f2, cancel2 := future.NewWithCancel(ctx, waitForCancel)
+	g.Add(cancel1)
+	g.Add(cancel2)
+	g.CancelAll()
+
+	for _, f := range []*future.Future[int]{f1, f2} {
+		if _, err := f.TryGet(ctx); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context canceled, got %v", err)
+		}
+	}
+}
+
+func TestCancelGroupWithCause(t *testing.T) {
+	ctx := context.Background()
+	g := future.NewCancelGroup()
+	causeCtx, cancel := context.WithCancelCause(ctx)
+	f := future.New(causeCtx, waitForCancel)
+	g.AddWithCause(cancel)
+
+	cause := errors.New("shutting down")
+	g.CancelAllWithCause(cause)
+
+	if _, err := f.TryGet(ctx); !errors.Is(err, cause) {
+		t.Fatalf("expected cause, got %v", err)
+	}
+}
+
+func TestNewWithCancelCause(t *testing.T) {
+	ctx := context.Background()
+	f, cancel := future.NewWithCancelCause(ctx, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	cause := errors.New("shutting down")
+	cancel(cause)
+
+	_, err := f.TryGet(ctx)
+	if !errors.Is(err, cause) {
+		t.Fatalf("expected cause, got %v", err)
+	}
+	if context.Cause(f.Context()) != cause {
+		t.Fatalf("expected context cause, got %v", context.Cause(f.Context()))
+	}
+}
+
+func TestNewWithCancelCauseNil(t *testing.T) {
+	ctx := context.Background()
+	f, cancel := future.NewWithCancelCause(ctx, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	cancel(nil)
+
+	if _, err := f.TryGet(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+}
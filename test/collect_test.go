@@ -0,0 +1,736 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestReduce(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.New(ctx, func(ctx context.Context) (int, error) {
+			return 2, nil
+		}),
+		future.Ok(ctx, 3),
+	}
+
+	val, err := future.Reduce(ctx, futures, "", func(acc string, val int) string {
+		return acc + fmt.Sprintf("%d", val)
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "123" {
+		t.Fatalf("expected 123, got %v", val)
+	}
+}
+
+func TestReduceError(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+	}
+
+	_, err := future.Reduce(ctx, futures, 0, func(acc int, val int) int {
+		return acc + val
+	})
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestFoldLeft(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[string]{
+		future.Ok(ctx, "a"),
+		future.Ok(ctx, "b"),
+		future.Ok(ctx, "c"),
+	}
+
+	f := future.FoldLeft(ctx, futures, "", func(ctx context.Context, acc string, val string) (string, error) {
+		return acc + val, nil
+	})
+
+	val, err := f.TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "abc" {
+		t.Fatalf("expected abc, got %v", val)
+	}
+}
+
+func TestFoldRight(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[string]{
+		future.Ok(ctx, "a"),
+		future.Ok(ctx, "b"),
+		future.Ok(ctx, "c"),
+	}
+
+	f := future.FoldRight(ctx, futures, "", func(ctx context.Context, acc string, val string) (string, error) {
+		return acc + val, nil
+	})
+
+	val, err := f.TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "cba" {
+		t.Fatalf("expected cba, got %v", val)
+	}
+}
+
+func TestFoldLeftError(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Ok(ctx, 2),
+	}
+
+	f := future.FoldLeft(ctx, futures, 0, func(ctx context.Context, acc int, val int) (int, error) {
+		if val == 2 {
+			return 0, errors.New("error")
+		}
+		return acc + val, nil
+	})
+
+	_, err := f.TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestAllFromChan(t *testing.T) {
+	ctx := context.Background()
+	ch := make(chan *future.Future[int])
+	go func() {
+		defer close(ch)
+		for i := range 3 {
+			ch <- future.New(ctx, func(ctx context.Context) (int, error) {
+				return i, nil
+			})
+		}
+	}()
+
+	vals, err := future.AllFromChan(ctx, ch)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(vals) != 3 {
+		t.Fatalf("expected 3 values, got %v", len(vals))
+	}
+	for i, val := range vals {
+		if val != i {
+			t.Fatalf("expected %d, got %v", i, val)
+		}
+	}
+}
+
+func TestAllFromChanCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ch := make(chan *future.Future[int])
+
+	_, err := future.AllFromChan(ctx, ch)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+}
+
+func TestMaxBy(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[string]{
+		future.Ok(ctx, "bb"),
+		future.Ok(ctx, "ccc"),
+		future.Ok(ctx, "a"),
+		future.Ok(ctx, "ddd"),
+	}
+
+	val, err := future.MaxBy(ctx, futures, func(val string) int {
+		return len(val)
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "ccc" {
+		t.Fatalf("expected ccc, got %v", val)
+	}
+}
+
+func TestMinBy(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[string]{
+		future.Ok(ctx, "bb"),
+		future.Ok(ctx, "ccc"),
+		future.Ok(ctx, "a"),
+	}
+
+	val, err := future.MinBy(ctx, futures, func(val string) int {
+		return len(val)
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "a" {
+		t.Fatalf("expected a, got %v", val)
+	}
+}
+
+func TestMaxByError(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+	}
+
+	_, err := future.MaxBy(ctx, futures, func(val int) int {
+		return val
+	})
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+
+	_, err = future.MaxBy(ctx, []*future.Future[int]{}, func(val int) int {
+		return val
+	})
+	if !errors.Is(err, future.ErrEmptySlice) {
+		t.Fatalf("expected ErrEmptySlice, got %v", err)
+	}
+}
+
+func TestContains(t *testing.T) {
+	ctx := context.Background()
+	block := make(chan struct{})
+	defer close(block)
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.New(ctx, func(ctx context.Context) (int, error) {
+			<-block
+			return 2, nil
+		}),
+		future.Ok(ctx, 3),
+	}
+
+	found, err := future.Contains(ctx, futures, 3).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !found {
+		t.Fatalf("expected target to be found")
+	}
+}
+
+func TestContainsMissing(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Ok(ctx, 2),
+	}
+
+	found, err := future.Contains(ctx, futures, 3).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found {
+		t.Fatalf("expected target not to be found")
+	}
+
+	futures = append(futures, future.Err[int](ctx, errors.New("error")))
+	_, err = future.Contains(ctx, futures, 3).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestSum(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Ok(ctx, 2),
+		future.Ok(ctx, 3),
+	}
+
+	val, err := future.Sum(ctx, futures).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 6 {
+		t.Fatalf("expected 6, got %v", val)
+	}
+
+	val, err = future.Sum(ctx, []*future.Future[int]{}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 0 {
+		t.Fatalf("expected 0, got %v", val)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[float64]{
+		future.Ok(ctx, 1.5),
+		future.Ok(ctx, 2.0),
+		future.Ok(ctx, 3.0),
+	}
+
+	val, err := future.Product(ctx, futures).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 9 {
+		t.Fatalf("expected 9, got %v", val)
+	}
+
+	val, err = future.Product(ctx, []*future.Future[float64]{}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+}
+
+func TestSumError(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+	}
+
+	_, err := future.Sum(ctx, futures).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestUnique(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[string]{
+		future.Ok(ctx, "b"),
+		future.Ok(ctx, "a"),
+		future.Ok(ctx, "b"),
+		future.Ok(ctx, "c"),
+		future.Ok(ctx, "a"),
+	}
+
+	vals, err := future.Unique(ctx, futures).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[b a c]" {
+		t.Fatalf("expected [b a c], got %v", vals)
+	}
+}
+
+func TestUniqueError(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+	}
+
+	_, err := future.Unique(ctx, futures).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestSortedBy(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[string]{
+		future.Ok(ctx, "ccc"),
+		future.Ok(ctx, "a"),
+		future.Ok(ctx, "bb"),
+		future.Ok(ctx, "d"),
+	}
+
+	vals, err := future.SortedBy(ctx, futures, func(val string) int {
+		return len(val)
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[a d bb ccc]" {
+		t.Fatalf("expected [a d bb ccc], got %v", vals)
+	}
+}
+
+func TestSortedByDesc(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 2),
+		future.Ok(ctx, 3),
+		future.Ok(ctx, 1),
+	}
+
+	vals, err := future.SortedByDesc(ctx, futures, func(val int) int {
+		return val
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[3 2 1]" {
+		t.Fatalf("expected [3 2 1], got %v", vals)
+	}
+}
+
+func TestSortedByError(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+	}
+
+	_, err := future.SortedBy(ctx, futures, func(val int) int {
+		return val
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestGroupResult(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[string]{
+		future.Ok(ctx, "apple"),
+		future.Ok(ctx, "banana"),
+		future.Ok(ctx, "avocado"),
+	}
+
+	groups, err := future.GroupResult(ctx, futures, func(val string) byte {
+		return val[0]
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(groups['a']) != "[apple avocado]" {
+		t.Fatalf("expected [apple avocado], got %v", groups['a'])
+	}
+	if fmt.Sprint(groups['b']) != "[banana]" {
+		t.Fatalf("expected [banana], got %v", groups['b'])
+	}
+}
+
+func TestGroupResultError(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+	}
+
+	_, err := future.GroupResult(ctx, futures, func(val int) int {
+		return val
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestRange(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	futures := []*future.Future[int]{
+		future.New(ctx, func(ctx context.Context) (int, error) {
+			<-release
+			return 1, nil
+		}),
+		future.Err[int](ctx, errors.New("error")),
+		future.Ok(ctx, 3),
+	}
+
+	var vals []int
+	var errs []error
+	for val, err := range future.Range(ctx, futures) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		vals = append(vals, val)
+		if len(vals) == 1 {
+			close(release)
+		}
+	}
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %v", errs)
+	}
+	if fmt.Sprint(vals) != "[3 1]" {
+		t.Fatalf("expected [3 1], got %v", vals)
+	}
+}
+
+func TestRangeCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	block := make(chan struct{})
+	defer close(block)
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.New(ctx, func(ctx context.Context) (int, error) {
+			<-block
+			return 2, nil
+		}),
+	}
+
+	count := 0
+	for range future.Range(ctx, futures) {
+		count++
+		cancel()
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 value, got %v", count)
+	}
+}
+
+func TestAny(t *testing.T) {
+	ctx := context.Background()
+	block := make(chan struct{})
+	defer close(block)
+	slow := future.New(ctx, func(ctx context.Context) (int, error) {
+		<-block
+		return 1, nil
+	})
+
+	val, err := future.Any(ctx, []*future.Future[int]{
+		slow,
+		future.Err[int](ctx, errors.New("error")),
+		future.Ok(ctx, 2),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 2 {
+		t.Fatalf("expected 2, got %v", val)
+	}
+
+	_, err = future.Any(ctx, []*future.Future[int]{
+		future.Err[int](ctx, errors.New("error 1")),
+		future.Err[int](ctx, errors.New("error 2")),
+	})
+	var aggErr *future.AggregateError
+	if !errors.As(err, &aggErr) || len(aggErr.Errors()) != 2 {
+		t.Fatalf("expected aggregate error, got %v", err)
+	}
+}
+
+func TestAllSettled(t *testing.T) {
+	ctx := context.Background()
+	results := future.AllSettled(ctx, []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+		future.Ok(ctx, 3),
+	})
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %v", len(results))
+	}
+	if results[0].Err != nil || results[0].Val != 1 {
+		t.Fatalf("expected 1, got %v", results[0])
+	}
+	if results[1].Err == nil || results[1].Err.Error() != "error" {
+		t.Fatalf("expected error, got %v", results[1])
+	}
+	if results[2].Err != nil || results[2].Val != 3 {
+		t.Fatalf("expected 3, got %v", results[2])
+	}
+}
+
+func TestRace(t *testing.T) {
+	ctx := context.Background()
+	slow := future.New(ctx, func(ctx context.Context) (int, error) {
+		time.Sleep(50 * time.Millisecond)
+		return 1, nil
+	})
+	fast := future.New(ctx, func(ctx context.Context) (int, error) {
+		return 0, errors.New("error")
+	})
+
+	_, err := future.Race(ctx, []*future.Future[int]{slow, fast})
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+
+	val, err := future.Race(ctx, []*future.Future[int]{slow})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+
+	_, err = future.Race(ctx, []*future.Future[int]{})
+	if !errors.Is(err, future.ErrEmptySlice) {
+		t.Fatalf("expected ErrEmptySlice, got %v", err)
+	}
+}
+
+func TestAnyIndex(t *testing.T) {
+	ctx := context.Background()
+	val, index, err := future.AnyIndex(ctx, []*future.Future[int]{
+		future.Err[int](ctx, errors.New("error")),
+		future.Ok(ctx, 2),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 2 || index != 1 {
+		t.Fatalf("expected 2 at index 1, got %v at index %v", val, index)
+	}
+
+	_, index, err = future.AnyIndex(ctx, []*future.Future[int]{
+		future.Err[int](ctx, errors.New("error 1")),
+		future.Err[int](ctx, errors.New("error 2")),
+	})
+	var aggErr *future.AggregateError
+	if !errors.As(err, &aggErr) || len(aggErr.Errors()) != 2 {
+		t.Fatalf("expected aggregate error, got %v", err)
+	}
+	if index != -1 {
+		t.Fatalf("expected -1, got %v", index)
+	}
+}
+
+func TestAwaitAll(t *testing.T) {
+	ctx := context.Background()
+	err := future.AwaitAll(ctx, []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Ok(ctx, 2),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err = future.AwaitAll(ctx, []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+	})
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestAwaitAny(t *testing.T) {
+	ctx := context.Background()
+	block := make(chan struct{})
+	defer close(block)
+	slow := func() *future.Future[int] {
+		return future.New(ctx, func(ctx context.Context) (int, error) {
+			<-block
+			return 1, nil
+		})
+	}
+
+	index, err := future.AwaitAny(ctx, []*future.Future[int]{slow(), future.Ok(ctx, 2)})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if index != 1 {
+		t.Fatalf("expected 1, got %v", index)
+	}
+
+	index, err = future.AwaitAny(ctx, []*future.Future[int]{slow(), future.Err[int](ctx, errors.New("error"))})
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if index != 1 {
+		t.Fatalf("expected 1, got %v", index)
+	}
+
+	_, err = future.AwaitAny(ctx, []*future.Future[int]{})
+	if !errors.Is(err, future.ErrEmptySlice) {
+		t.Fatalf("expected ErrEmptySlice, got %v", err)
+	}
+}
+
+func TestEachSettled(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+		future.Ok(ctx, 3),
+	}
+
+	vals := make([]int, len(futures))
+	errs := make([]error, len(futures))
+	calls := 0
+	future.EachSettled(ctx, futures, func(index int, val int, err error) {
+		calls++
+		vals[index] = val
+		errs[index] = err
+	})
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %v", calls)
+	}
+	if vals[0] != 1 || vals[2] != 3 {
+		t.Fatalf("expected [1 _ 3], got %v", vals)
+	}
+	if errs[1] == nil || errs[1].Error() != "error" {
+		t.Fatalf("expected error, got %v", errs[1])
+	}
+}
+
+func TestWhenDone(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+	}
+
+	var results []int
+	var errs []error
+	_, err := future.WhenDone(ctx, futures, func(ctx context.Context, r []int, e []error) {
+		results, errs = r, e
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(results) != 2 || results[0] != 1 || errs[0] != nil {
+		t.Fatalf("expected first future to succeed, got %v %v", results, errs)
+	}
+	if errs[1] == nil || errs[1].Error() != "error" {
+		t.Fatalf("expected second future to fail, got %v", errs[1])
+	}
+}
+
+func TestCollect(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[string]{
+		future.Ok(ctx, "a"),
+		future.Ok(ctx, "b"),
+		future.Ok(ctx, "a"),
+	}
+
+	counts, err := future.Collect(ctx, futures, map[string]int{}, func(acc map[string]int, val string) map[string]int {
+		acc[val]++
+		return acc
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if counts["a"] != 2 || counts["b"] != 1 {
+		t.Fatalf("expected map[a:2 b:1], got %v", counts)
+	}
+}
+
+func TestCollectError(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+	}
+
+	_, err := future.Collect(ctx, futures, 0, func(acc int, val int) int {
+		return acc + val
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestAfter(t *testing.T) {
+	ctx := context.Background()
+	order := make(chan string, 2)
+	dep := future.New(ctx, func(ctx context.Context) (any, error) {
+		order <- "dep"
+		return nil, nil
+	})
+
+	f := future.After(ctx, dep, func(ctx context.Context) (int, error) {
+		order <- "after"
+		return 1, nil
+	})
+
+	val, err := f.TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+	if first := <-order; first != "dep" {
+		t.Fatalf("expected dep to run first, got %v", first)
+	}
+}
+
+func TestAfterError(t *testing.T) {
+	ctx := context.Background()
+	dep := future.Err[any](ctx, errors.New("error"))
+
+	called := false
+	f := future.After(ctx, dep, func(ctx context.Context) (int, error) {
+		called = true
+		return 1, nil
+	})
+
+	_, err := f.TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if called {
+		t.Fatalf("expected fun not to be called")
+	}
+}
+
+func TestCompose(t *testing.T) {
+	ctx := context.Background()
+	double := func(ctx context.Context, val int) *future.Future[int] {
+		return future.Ok(ctx, val*2)
+	}
+	format := func(ctx context.Context, val int) *future.Future[string] {
+		return future.Ok(ctx, fmt.Sprintf("%d", val))
+	}
+
+	composed := future.Compose(double, format)
+
+	val, err := composed(ctx, 21).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "42" {
+		t.Fatalf("expected 42, got %v", val)
+	}
+}
+
+func TestComposeError(t *testing.T) {
+	ctx := context.Background()
+	fail := func(ctx context.Context, val int) *future.Future[int] {
+		return future.Err[int](ctx, errors.New("error"))
+	}
+	called := false
+	next := func(ctx context.Context, val int) *future.Future[int] {
+		called = true
+		return future.Ok(ctx, val)
+	}
+
+	_, err := future.Compose(fail, next)(ctx, 1).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if called {
+		t.Fatalf("expected second function not to be called")
+	}
+}
+
+func TestCompose3(t *testing.T) {
+	ctx := context.Background()
+	double := func(ctx context.Context, val int) *future.Future[int] {
+		return future.Ok(ctx, val*2)
+	}
+	format := func(ctx context.Context, val int) *future.Future[string] {
+		return future.Ok(ctx, fmt.Sprintf("%d", val))
+	}
+	length := func(ctx context.Context, val string) *future.Future[int] {
+		return future.Ok(ctx, len(val))
+	}
+
+	val, err := future.Compose3(double, format, length)(ctx, 50).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 3 {
+		t.Fatalf("expected 3, got %v", val)
+	}
+}
+
+func TestPipe(t *testing.T) {
+	ctx := context.Background()
+	addOne := func(f *future.Future[int]) *future.Future[int] {
+		return future.Map(f, func(ctx context.Context, val int) int {
+			return val + 1
+		})
+	}
+	double := func(f *future.Future[int]) *future.Future[int] {
+		return future.Map(f, func(ctx context.Context, val int) int {
+			return val * 2
+		})
+	}
+
+	val, err := future.Pipe(future.Ok(ctx, 1), addOne, double).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 4 {
+		t.Fatalf("expected 4, got %v", val)
+	}
+}
+
+func TestChain(t *testing.T) {
+	ctx := context.Background()
+	val, err := future.Chain(future.Ok(ctx, 1)).
+		AndThen(func(ctx context.Context, val int) *future.Future[int] {
+			return future.Ok(ctx, val+1)
+		}).
+		Map(func(ctx context.Context, val int) int {
+			return val * 10
+		}).
+		TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 20 {
+		t.Fatalf("expected 20, got %v", val)
+	}
+}
+
+func TestChainError(t *testing.T) {
+	ctx := context.Background()
+	called := false
+	_, err := future.Chain(future.Ok(ctx, 1)).
+		AndThen(func(ctx context.Context, val int) *future.Future[int] {
+			return future.Err[int](ctx, errors.New("error"))
+		}).
+		Map(func(ctx context.Context, val int) int {
+			called = true
+			return val
+		}).
+		TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if called {
+		t.Fatalf("expected Map not to be called")
+	}
+}
+
+func TestCond(t *testing.T) {
+	ctx := context.Background()
+	calls := []string{}
+	ifTrue := func(ctx context.Context) (string, error) {
+		calls = append(calls, "true")
+		return "yes", nil
+	}
+	ifFalse := func(ctx context.Context) (string, error) {
+		calls = append(calls, "false")
+		return "no", nil
+	}
+
+	val, err := future.Cond(ctx, future.Ok(ctx, true), ifTrue, ifFalse).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "yes" {
+		t.Fatalf("expected yes, got %v", val)
+	}
+	val, err = future.Cond(ctx, future.Ok(ctx, false), ifTrue, ifFalse).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "no" {
+		t.Fatalf("expected no, got %v", val)
+	}
+
+	_, err = future.Cond(ctx, future.Err[bool](ctx, errors.New("error")), ifTrue, ifFalse).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if fmt.Sprint(calls) != "[true false]" {
+		t.Fatalf("expected one call per branch, got %v", calls)
+	}
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+	"github.com/Olian04/go-future/future/encoding"
+)
+
+func TestFutureJSONMarshal(t *testing.T) {
+	ctx := context.Background()
+	block := make(chan struct{})
+	defer close(block)
+	cases := []struct {
+		f        *future.Future[int]
+		expected string
+	}{
+		{future.Ok(ctx, 42), `{"state":"done","value":42}`},
+		{future.Ok(ctx, 0), `{"state":"done","value":0}`},
+		{future.Err[int](ctx, errors.New("error")), `{"state":"error","error":"error"}`},
+		{future.Err[int](ctx, nil), `{"state":"error"}`},
+		{future.New(ctx, func(ctx context.Context) (int, error) {
+			<-block
+			return 1, nil
+		}), `{"state":"pending"}`},
+	}
+	for _, c := range cases {
+		data, err := json.Marshal(encoding.FutureJSON[int]{Future: c.f})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if string(data) != c.expected {
+			t.Fatalf("expected %s, got %s", c.expected, data)
+		}
+	}
+}
+
+func TestFutureJSONNil(t *testing.T) {
+	type response struct {
+		Result encoding.FutureJSON[int] `json:"result"`
+	}
+	data, err := json.Marshal(response{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if string(data) != `{"result":null}` {
+		t.Fatalf("expected null future, got %s", data)
+	}
+
+	var decoded response
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if decoded.Result.Future != nil {
+		t.Fatalf("expected no future, got %#v", decoded.Result.Future)
+	}
+}
+
+func TestFutureJSONUnmarshal(t *testing.T) {
+	ctx := context.Background()
+	var f encoding.FutureJSON[int]
+	if err := json.Unmarshal([]byte(`{"state":"done","value":42}`), &f); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if f.State() != future.StateDone {
+		t.Fatalf("expected done state, got %v", f.State())
+	}
+	if val := f.MustGet(ctx); val != 42 {
+		t.Fatalf("expected 42, got %v", val)
+	}
+
+	if err := json.Unmarshal([]byte(`{"state":"error","error":"error"}`), &f); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, err := f.TryGet(ctx); err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"state":"pending"}`), &f); err == nil {
+		t.Fatalf("expected pending future to be rejected")
+	}
+}
@@ -0,0 +1,95 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestAggregateError(t *testing.T) {
+	ctx := context.Background()
+	errA := errors.New("error a")
+	errB := errors.New("error b")
+
+	_, err := future.All(ctx, []*future.Future[int]{
+		future.Err[int](ctx, errA),
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errB),
+	})
+
+	var aggErr *future.AggregateError
+	if !errors.As(err, &aggErr) {
+		t.Fatalf("expected AggregateError, got %v", err)
+	}
+	if len(aggErr.Errors()) != 2 {
+		t.Fatalf("expected 2 errors, got %v", len(aggErr.Errors()))
+	}
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Fatalf("expected error to match both causes, got %v", err)
+	}
+	if !strings.Contains(aggErr.String(), "2 errors occurred") {
+		t.Fatalf("expected error listing, got %v", aggErr.String())
+	}
+}
+
+func TestAggregateErrorSingle(t *testing.T) {
+	ctx := context.Background()
+	_, err := future.All(ctx, []*future.Future[int]{
+		future.Err[int](ctx, errors.New("error")),
+		future.Ok(ctx, 1),
+	})
+
+	var aggErr *future.AggregateError
+	if errors.As(err, &aggErr) {
+		t.Fatalf("expected plain error, got %v", aggErr)
+	}
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestAggregateErrorPending(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	defer close(release)
+	fast := future.New(ctx, func(ctx context.Context) (int, error) {
+		return 0, errors.New("error a")
+	})
+	slow := future.New(ctx, func(ctx context.Context) (int, error) {
+		<-release
+		return 0, errors.New("error b")
+	})
+
+	_, err := future.All(ctx, []*future.Future[int]{fast, slow})
+	if err == nil || err.Error() != "error a" {
+		t.Fatalf("expected only the first failure, got %v", err)
+	}
+	if slow.State() != future.StatePending {
+		t.Fatalf("expected All not to wait for the slow future")
+	}
+}
+
+func TestIsContextError(t *testing.T) {
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	canceledErr := fmt.Errorf("wrapped: %w", canceled.Err())
+	expiredErr := expired.Err()
+	otherErr := errors.New("error")
+
+	if !future.IsContextCanceled(canceledErr) || future.IsContextCanceled(expiredErr) {
+		t.Fatalf("expected only canceled error to be canceled")
+	}
+	if !future.IsDeadlineExceeded(expiredErr) || future.IsDeadlineExceeded(canceledErr) {
+		t.Fatalf("expected only expired error to exceed deadline")
+	}
+	if !future.IsContextError(canceledErr) || !future.IsContextError(expiredErr) || future.IsContextError(otherErr) {
+		t.Fatalf("expected only context errors to be context errors")
+	}
+}
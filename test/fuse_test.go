@@ -0,0 +1,46 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestFuse(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	fail := true
+	fuse := future.NewFuse(func(ctx context.Context) (int, error) {
+		calls++
+		if fail {
+			return 0, errors.New("error")
+		}
+		return calls, nil
+	})
+
+	if _, err := fuse.Invoke(ctx).TryGet(ctx); err == nil {
+		t.Fatalf("expected error")
+	}
+	if fuse.Err() == nil || fuse.Err().Error() != "error" {
+		t.Fatalf("expected blown fuse, got %v", fuse.Err())
+	}
+
+	fail = false
+	if _, err := fuse.Invoke(ctx).TryGet(ctx); err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 call, got %v", calls)
+	}
+
+	fuse.Reset()
+	val, err := fuse.Invoke(ctx).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 2 {
+		t.Fatalf("expected 2, got %v", val)
+	}
+}
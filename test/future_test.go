@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"sync"
 	"testing"
 
 	"github.com/Olian04/go-future/future"
@@ -36,6 +38,54 @@ func TestFutureError(t *testing.T) {
 	}
 }
 
+func TestTryGetConcurrent(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	f := future.New(ctx, func(ctx context.Context) (int, error) {
+		<-release
+		return 42, nil
+	})
+
+	var wg sync.WaitGroup
+	vals := make([]int, 16)
+	errs := make([]error, len(vals))
+	for i := range vals {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			vals[i], errs[i] = f.TryGet(ctx)
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	for i := range vals {
+		if errs[i] != nil {
+			t.Fatalf("expected no error, got %v", errs[i])
+		}
+		if vals[i] != 42 {
+			t.Fatalf("expected 42, got %v", vals[i])
+		}
+	}
+}
+
+func TestBackground(t *testing.T) {
+	f := future.Background(func(ctx context.Context) (int, error) {
+		if ctx.Done() != nil {
+			return 0, errors.New("expected a context that is never canceled")
+		}
+		return 1, nil
+	})
+
+	val, err := f.TryGet(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+}
+
 func TestGetOr(t *testing.T) {
 	ctx := context.Background()
 	f := future.New(ctx, func(ctx context.Context) (int, error) {
@@ -75,6 +125,29 @@ func TestMustGet(t *testing.T) {
 	f.MustGet(ctx)
 }
 
+func TestGoString(t *testing.T) {
+	ctx := context.Background()
+	block := make(chan struct{})
+	defer close(block)
+	cases := []struct {
+		f        *future.Future[int]
+		expected string
+	}{
+		{future.Ok(ctx, 42), "future.Ok(context.Background(), 42)"},
+		{future.Err[int](ctx, errors.New("connection refused")), `future.Err[int](context.Background(), errors.New("connection refused"))`},
+		{future.Err[int](ctx, nil), "future.Err[int](context.Background(), nil)"},
+		{future.New(ctx, func(ctx context.Context) (int, error) {
+			<-block
+			return 1, nil
+		}), "future.New(...)"},
+	}
+	for _, c := range cases {
+		if got := fmt.Sprintf("%#v", c.f); got != c.expected {
+			t.Fatalf("expected %s, got %s", c.expected, got)
+		}
+	}
+}
+
 func TestMap(t *testing.T) {
 	ctx := context.Background()
 	f := future.New(ctx, func(ctx context.Context) (int, error) {
@@ -94,6 +167,34 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func TestTryMap(t *testing.T) {
+	ctx := context.Background()
+	parse := func(ctx context.Context, val string) (int, error) {
+		return strconv.Atoi(val)
+	}
+
+	val, err := future.TryMap(future.Ok(ctx, "42"), parse).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 42 {
+		t.Fatalf("expected 42, got %v", val)
+	}
+
+	f := future.TryMap(future.Ok(ctx, "nan"), parse)
+	if _, err := f.TryGet(ctx); !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("expected syntax error, got %v", err)
+	}
+	if f.State() != future.StateError {
+		t.Fatalf("expected error state, got %v", f.State())
+	}
+
+	_, err = future.TryMap(future.Err[string](ctx, errors.New("error")), parse).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
 func TestMapError(t *testing.T) {
 	ctx := context.Background()
 	f := future.New(ctx, func(ctx context.Context) (int, error) {
@@ -110,22 +211,91 @@ func TestMapError(t *testing.T) {
 	}
 }
 
-func TestFlatMap(t *testing.T) {
+func TestMapSettled(t *testing.T) {
 	ctx := context.Background()
-	f := future.New(ctx, func(ctx context.Context) (int, error) {
-		return 1, nil
-	})
+	describe := func(ctx context.Context, val int, err error) (string, error) {
+		if err != nil {
+			return "failed: " + err.Error(), nil
+		}
+		return fmt.Sprintf("ok: %d", val), nil
+	}
 
-	flatMapped := future.FlatMap(f, func(ctx context.Context, val int) *future.Future[string] {
-		return future.Ok(ctx, fmt.Sprintf("%d", val))
-	})
+	val, err := future.MapSettled(future.Ok(ctx, 1), describe).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "ok: 1" {
+		t.Fatalf("expected ok: 1, got %v", val)
+	}
 
-	val, err := flatMapped.TryGet(ctx)
+	val, err = future.MapSettled(future.Err[int](ctx, errors.New("error")), describe).TryGet(ctx)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
-	if val != "1" {
-		t.Fatalf("expected 1, got %v", val)
+	if val != "failed: error" {
+		t.Fatalf("expected failed: error, got %v", val)
+	}
+}
+
+func TestFlatMapResult(t *testing.T) {
+	ctx := context.Background()
+	errRetry := errors.New("retry")
+	recoverRetry := func(ctx context.Context, val int, err error) *future.Future[int] {
+		if errors.Is(err, errRetry) {
+			return future.Ok(ctx, 0)
+		}
+		if err != nil {
+			return future.Err[int](ctx, fmt.Errorf("wrapped: %w", err))
+		}
+		return future.Ok(ctx, val+1)
+	}
+
+	val, err := future.FlatMapResult(future.Ok(ctx, 1), recoverRetry).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 2 {
+		t.Fatalf("expected 2, got %v", val)
+	}
+
+	val, err = future.FlatMapResult(future.Err[int](ctx, errRetry), recoverRetry).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 0 {
+		t.Fatalf("expected 0, got %v", val)
+	}
+
+	_, err = future.FlatMapResult(future.Err[int](ctx, errors.New("error")), recoverRetry).TryGet(ctx)
+	if err == nil || err.Error() != "wrapped: error" {
+		t.Fatalf("expected wrapped error, got %v", err)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	flatMaps := map[string]func(*future.Future[int], func(context.Context, int) *future.Future[string]) *future.Future[string]{
+		"FlatMap":  future.FlatMap[int, string],
+		"MapAsync": future.MapAsync[int, string],
+	}
+	for name, flatMap := range flatMaps {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			f := future.New(ctx, func(ctx context.Context) (int, error) {
+				return 1, nil
+			})
+
+			flatMapped := flatMap(f, func(ctx context.Context, val int) *future.Future[string] {
+				return future.Ok(ctx, fmt.Sprintf("%d", val))
+			})
+
+			val, err := flatMapped.TryGet(ctx)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if val != "1" {
+				t.Fatalf("expected 1, got %v", val)
+			}
+		})
 	}
 }
 
@@ -153,6 +323,74 @@ func TestAll(t *testing.T) {
 	}
 }
 
+func TestAllVariadic(t *testing.T) {
+	ctx := context.Background()
+	vals, err := future.AllVariadic(ctx, future.Ok(ctx, 1), future.Ok(ctx, 2), future.Ok(ctx, 3))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(vals) != 3 || vals[0] != 1 || vals[1] != 2 || vals[2] != 3 {
+		t.Fatalf("expected [1 2 3], got %v", vals)
+	}
+}
+
+func TestAllWithProgress(t *testing.T) {
+	ctx := context.Background()
+	futures := []*future.Future[int]{
+		future.Ok(ctx, 1),
+		future.New(ctx, func(ctx context.Context) (int, error) {
+			return 2, nil
+		}),
+		future.Ok(ctx, 3),
+	}
+
+	calls := 0
+	all, err := future.AllWithProgress(ctx, futures, func(completed, total int) {
+		calls++
+		if completed != calls {
+			t.Fatalf("expected %d completed, got %v", calls, completed)
+		}
+		if total != 3 {
+			t.Fatalf("expected 3 total, got %v", total)
+		}
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("expected 3 values, got %v", len(all))
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 progress calls, got %v", calls)
+	}
+}
+
+func TestIterParWithProgress(t *testing.T) {
+	ctx := context.Background()
+	arr := []int{1, 2, 3, 4}
+	calls := 0
+	vals, err := future.IterParWithProgress(ctx, arr, func(ctx context.Context, val int) (int, error) {
+		return val * 2, nil
+	}, func(completed, total int) {
+		calls++
+		if completed != calls {
+			t.Fatalf("expected %d completed, got %v", calls, completed)
+		}
+		if total != 4 {
+			t.Fatalf("expected 4 total, got %v", total)
+		}
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[2 4 6 8]" {
+		t.Fatalf("expected [2 4 6 8], got %v", vals)
+	}
+	if calls != 4 {
+		t.Fatalf("expected 4 progress calls, got %v", calls)
+	}
+}
+
 func TestIterPar(t *testing.T) {
 	ctx := context.Background()
 	arr := []int{1, 2, 3, 4, 5}
@@ -191,9 +429,21 @@ func BenchmarkIterBaseline(b *testing.B) {
 func BenchmarkIterPar(b *testing.B) {
 	ctx := context.Background()
 	arr := make([]int, 100_000)
+	b.ReportAllocs()
 	for b.Loop() {
 		future.IterPar(ctx, arr, func(ctx context.Context, val int) (int, error) {
 			return Fibbonaci(15), nil
 		})
 	}
 }
+
+func BenchmarkIterParUnbounded(b *testing.B) {
+	ctx := context.Background()
+	arr := make([]int, 100_000)
+	b.ReportAllocs()
+	for b.Loop() {
+		future.IterParN(ctx, arr, len(arr), func(ctx context.Context, val int) (int, error) {
+			return Fibbonaci(15), nil
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestFutureMap(t *testing.T) {
+	ctx := context.Background()
+	var m future.FutureMap[string, int]
+	m.Set(ctx, "a", func(ctx context.Context) (int, error) {
+		return 1, nil
+	})
+	m.Set(ctx, "b", func(ctx context.Context) (int, error) {
+		return 2, nil
+	})
+	m.Set(ctx, "c", func(ctx context.Context) (int, error) {
+		return 3, nil
+	})
+	m.Delete("c")
+
+	if _, ok := m.Get("c"); ok {
+		t.Fatalf("expected c to be deleted")
+	}
+	f, ok := m.Get("a")
+	if !ok {
+		t.Fatalf("expected a to be present")
+	}
+	if val := f.MustGet(ctx); val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+
+	vals, err := m.WaitAll(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(vals) != 2 || vals["a"] != 1 || vals["b"] != 2 {
+		t.Fatalf("expected map[a:1 b:2], got %v", vals)
+	}
+}
+
+func TestFutureMapError(t *testing.T) {
+	ctx := context.Background()
+	var m future.FutureMap[string, int]
+	m.Set(ctx, "a", func(ctx context.Context) (int, error) {
+		return 0, errors.New("error")
+	})
+
+	_, err := m.WaitAll(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestFutureMapWaitAllSettles(t *testing.T) {
+	ctx := context.Background()
+	var m future.FutureMap[string, int]
+	m.Set(ctx, "fast", func(ctx context.Context) (int, error) {
+		return 0, errors.New("error")
+	})
+	m.Set(ctx, "slow", func(ctx context.Context) (int, error) {
+		time.Sleep(20 * time.Millisecond)
+		return 1, nil
+	})
+
+	_, err := m.WaitAll(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	slow, _ := m.Get("slow")
+	if slow.State() != future.StateDone {
+		t.Fatalf("expected WaitAll to wait for the slow future, got %v", slow.State())
+	}
+}
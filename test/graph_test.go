@@ -0,0 +1,91 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestDepGraph(t *testing.T) {
+	ctx := context.Background()
+	g := future.NewDepGraph[int]()
+	steps := make(chan string, 4)
+	add := func(name string, deps []string, val int) {
+		err := g.Add(name, deps, func(ctx context.Context) (int, error) {
+			steps <- name
+			return val, nil
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	add("d", []string{"b", "c"}, 4)
+	add("b", []string{"a"}, 2)
+	add("c", []string{"a"}, 3)
+	add("a", nil, 1)
+
+	vals, err := g.Run(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for name, val := range map[string]int{"a": 1, "b": 2, "c": 3, "d": 4} {
+		if vals[name] != val {
+			t.Fatalf("expected %s to be %d, got %v", name, val, vals[name])
+		}
+	}
+	if first := <-steps; first != "a" {
+		t.Fatalf("expected a to run first, got %v", first)
+	}
+}
+
+func TestDepGraphError(t *testing.T) {
+	ctx := context.Background()
+	g := future.NewDepGraph[int]()
+	rootErr := errors.New("error")
+	g.Add("a", nil, func(ctx context.Context) (int, error) {
+		return 0, rootErr
+	})
+	called := false
+	g.Add("b", []string{"a"}, func(ctx context.Context) (int, error) {
+		called = true
+		return 1, nil
+	})
+
+	_, err := g.Run(ctx)
+	if !errors.Is(err, rootErr) {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if called {
+		t.Fatalf("expected dependent node not to run")
+	}
+}
+
+func TestDepGraphCycle(t *testing.T) {
+	g := future.NewDepGraph[int]()
+	fun := func(ctx context.Context) (int, error) {
+		return 0, nil
+	}
+	if err := g.Add("a", []string{"b"}, fun); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := g.Add("b", []string{"a"}, fun); err == nil {
+		t.Fatalf("expected cycle error")
+	}
+	if err := g.Add("c", []string{"c"}, fun); err == nil {
+		t.Fatalf("expected cycle error")
+	}
+}
+
+func TestDepGraphUnknownDependency(t *testing.T) {
+	ctx := context.Background()
+	g := future.NewDepGraph[int]()
+	g.Add("a", []string{"missing"}, func(ctx context.Context) (int, error) {
+		return 0, nil
+	})
+
+	if _, err := g.Run(ctx); err == nil {
+		t.Fatalf("expected unknown dependency error")
+	}
+}
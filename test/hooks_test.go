@@ -0,0 +1,94 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestSetGlobalErrorTransform(t *testing.T) {
+	ctx := context.Background()
+	future.SetGlobalErrorTransform(func(err error) error {
+		return fmt.Errorf("annotated: %w", err)
+	})
+	defer future.SetGlobalErrorTransform(nil)
+
+	cause := errors.New("error")
+	_, err := future.New(ctx, func(ctx context.Context) (int, error) {
+		return 0, cause
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "annotated: error" {
+		t.Fatalf("expected annotated error, got %v", err)
+	}
+	if !errors.Is(err, cause) {
+		t.Fatalf("expected annotated error to wrap cause, got %v", err)
+	}
+}
+
+func TestSetGlobalErrorTransformOnce(t *testing.T) {
+	ctx := context.Background()
+	future.SetGlobalErrorTransform(func(err error) error {
+		return fmt.Errorf("annotated: %w", err)
+	})
+	defer future.SetGlobalErrorTransform(nil)
+
+	f := future.New(ctx, func(ctx context.Context) (int, error) {
+		return 0, errors.New("error")
+	})
+	double := func(ctx context.Context, val int) int {
+		return val * 2
+	}
+	_, err := future.Map(future.Map(f, double), double).TryGet(ctx)
+	if err == nil || err.Error() != "annotated: error" {
+		t.Fatalf("expected error to be annotated once, got %v", err)
+	}
+
+	_, err = future.TryMap(future.Ok(ctx, 1), func(ctx context.Context, val int) (int, error) {
+		return 0, errors.New("map error")
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "annotated: map error" {
+		t.Fatalf("expected mapper error to be annotated, got %v", err)
+	}
+}
+
+func TestSetGlobalErrorTransformNil(t *testing.T) {
+	ctx := context.Background()
+	future.SetGlobalErrorTransform(func(err error) error {
+		return nil
+	})
+	defer future.SetGlobalErrorTransform(nil)
+
+	_, err := future.New(ctx, func(ctx context.Context) (int, error) {
+		return 0, errors.New("error")
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected original error, got %v", err)
+	}
+}
+
+type traceKey struct{}
+
+type tracePropagator struct{}
+
+func (tracePropagator) Inject(parent context.Context) context.Context {
+	return context.WithValue(parent, traceKey{}, "trace-id")
+}
+
+func TestSetContextPropagator(t *testing.T) {
+	ctx := context.Background()
+	future.SetContextPropagator(tracePropagator{})
+	defer future.SetContextPropagator(nil)
+
+	val, err := future.New(ctx, func(ctx context.Context) (any, error) {
+		return ctx.Value(traceKey{}), nil
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "trace-id" {
+		t.Fatalf("expected trace-id, got %v", val)
+	}
+}
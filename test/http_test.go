@@ -0,0 +1,60 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	futurehttp "github.com/Olian04/go-future/future/http"
+)
+
+func readBody(resp *http.Response) (string, error) {
+	body, err := io.ReadAll(resp.Body)
+	return string(body), err
+}
+
+func TestNewFromHTTPResponse(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "hello")
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	val, err := futurehttp.NewFromHTTPResponse(ctx, server.Client(), req, readBody).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "hello" {
+		t.Fatalf("expected hello, got %v", val)
+	}
+}
+
+func TestNewFromHTTPResponseStatus(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	_, err = futurehttp.NewFromHTTPResponse(ctx, server.Client(), req, readBody).TryGet(ctx)
+	var httpErr *futurehttp.HTTPError
+	if !errors.As(err, &httpErr) {
+		t.Fatalf("expected HTTPError, got %v", err)
+	}
+	if httpErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected 404, got %v", httpErr.StatusCode)
+	}
+}
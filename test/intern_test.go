@@ -0,0 +1,66 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestIntern(t *testing.T) {
+	ctx := context.Background()
+	future.SetInternTTL(time.Hour)
+	defer future.SetInternTTL(0)
+
+	calls := 0
+	fun := func(ctx context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}
+	a := future.Intern("intern", fun, ctx)
+	b := future.Intern("intern", fun, ctx)
+	if a != b {
+		t.Fatalf("expected the same future for the same key")
+	}
+	val, err := a.TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+	if c := future.Intern("intern", fun, ctx); c != a {
+		t.Fatalf("expected the settled future to stay interned")
+	}
+
+	s := future.Intern("intern", func(ctx context.Context) (string, error) {
+		return "other", nil
+	}, ctx)
+	if val, _ := s.TryGet(ctx); val != "other" {
+		t.Fatalf("expected keys to be scoped by type, got %v", val)
+	}
+}
+
+func TestInternEviction(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	fun := func(ctx context.Context) (int, error) {
+		calls++
+		return calls, nil
+	}
+	future.Intern("evicted", fun, ctx).TryGet(ctx)
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		f := future.Intern("evicted", fun, ctx)
+		val, _ := f.TryGet(ctx)
+		if val == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the settled future to be evicted")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,261 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestIterParGrouped(t *testing.T) {
+	ctx := context.Background()
+	arr := []int{1, 2, 3, 4, 5}
+	groups, err := future.IterParGrouped(ctx, arr, func(ctx context.Context, val int) (int, error) {
+		return val * 3, nil
+	}, func(val int) bool {
+		return val%2 == 0
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(groups[true]) != 2 {
+		t.Fatalf("expected 2 even values, got %v", groups[true])
+	}
+	if len(groups[false]) != 3 {
+		t.Fatalf("expected 3 odd values, got %v", groups[false])
+	}
+	for i, val := range []int{3, 9, 15} {
+		if groups[false][i] != val {
+			t.Fatalf("expected %d, got %v", val, groups[false][i])
+		}
+	}
+}
+
+func TestIterParGroupedError(t *testing.T) {
+	ctx := context.Background()
+	arr := []int{1, 2, 3}
+	_, err := future.IterParGrouped(ctx, arr, func(ctx context.Context, val int) (int, error) {
+		if val == 2 {
+			return 0, errors.New("error")
+		}
+		return val, nil
+	}, func(val int) int {
+		return val
+	})
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestIterParN(t *testing.T) {
+	ctx := context.Background()
+	arr := []int{1, 2, 3, 4, 5, 6, 7}
+	var running, peak atomic.Int32
+	vals, err := future.IterParN(ctx, arr, 2, func(ctx context.Context, val int) (int, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return val * 2, nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if peak.Load() > 2 {
+		t.Fatalf("expected at most 2 concurrent calls, got %v", peak.Load())
+	}
+	for i, val := range vals {
+		if val != arr[i]*2 {
+			t.Fatalf("expected %d, got %v", arr[i]*2, val)
+		}
+	}
+}
+
+func TestIterParNError(t *testing.T) {
+	ctx := context.Background()
+	arr := []int{1, 2, 3, 4, 5}
+	_, err := future.IterParN(ctx, arr, 2, func(ctx context.Context, val int) (int, error) {
+		if val == 3 {
+			return 0, errors.New("error")
+		}
+		return val, nil
+	})
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestSetDefaultParallelism(t *testing.T) {
+	ctx := context.Background()
+	future.SetDefaultParallelism(1)
+	defer future.SetDefaultParallelism(runtime.GOMAXPROCS(0) * 2)
+
+	arr := []int{1, 2, 3, 4}
+	var running atomic.Int32
+	_, err := future.IterPar(ctx, arr, func(ctx context.Context, val int) (int, error) {
+		if running.Add(1) > 1 {
+			return 0, errors.New("too many concurrent calls")
+		}
+		defer running.Add(-1)
+		time.Sleep(time.Millisecond)
+		return val, nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestIterParMap(t *testing.T) {
+	ctx := context.Background()
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	out, err := future.IterParMap(ctx, m, func(ctx context.Context, key string, val int) (string, error) {
+		return strings.Repeat(key, val), nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(out) != 3 {
+		t.Fatalf("expected 3 entries, got %v", len(out))
+	}
+	for key, val := range map[string]string{"a": "a", "b": "bb", "c": "ccc"} {
+		if out[key] != val {
+			t.Fatalf("expected %s, got %v", val, out[key])
+		}
+	}
+}
+
+func TestIterParMapError(t *testing.T) {
+	ctx := context.Background()
+	m := map[string]int{"a": 1, "b": 2}
+	_, err := future.IterParMap(ctx, m, func(ctx context.Context, key string, val int) (int, error) {
+		if key == "b" {
+			return 0, errors.New("error")
+		}
+		return val, nil
+	})
+	var keyErr *future.MapKeyError[string]
+	if !errors.As(err, &keyErr) {
+		t.Fatalf("expected MapKeyError, got %v", err)
+	}
+	if keyErr.Key != "b" || keyErr.Err.Error() != "error" {
+		t.Fatalf("expected key b to fail, got %v", keyErr)
+	}
+}
+
+func TestIterParSeq(t *testing.T) {
+	ctx := context.Background()
+	vals, err := future.IterParSeq(ctx, slices.Values([]int{1, 2, 3}), func(ctx context.Context, val int) (int, error) {
+		return val * 2, nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(vals) != 3 {
+		t.Fatalf("expected 3 values, got %v", len(vals))
+	}
+	for i, val := range vals {
+		if val != (i+1)*2 {
+			t.Fatalf("expected %d, got %v", (i+1)*2, val)
+		}
+	}
+}
+
+func TestRepeatN(t *testing.T) {
+	ctx := context.Background()
+	vals, err := future.RepeatN(ctx, 4, func(ctx context.Context, i int) (int, error) {
+		return i * i, nil
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[0 1 4 9]" {
+		t.Fatalf("expected [0 1 4 9], got %v", vals)
+	}
+}
+
+func TestRepeatNConcurrent(t *testing.T) {
+	ctx := context.Background()
+	n := runtime.GOMAXPROCS(0)*2 + 10
+	var running atomic.Int64
+	all := make(chan struct{})
+	_, err := future.RepeatN(ctx, n, func(ctx context.Context, i int) (int, error) {
+		if running.Add(1) == int64(n) {
+			close(all)
+		}
+		select {
+		case <-all:
+			return i, nil
+		case <-time.After(time.Second):
+			return 0, fmt.Errorf("only %d calls running at once", running.Load())
+		}
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected all %d calls to run at once, got %v", n, err)
+	}
+}
+
+func TestIterParErrors(t *testing.T) {
+	ctx := context.Background()
+	arr := []int{1, 2, 3, 4}
+	successes, failures := future.IterParErrors(ctx, arr, func(ctx context.Context, val int) (int, error) {
+		if val%2 == 0 {
+			return 0, fmt.Errorf("even %d", val)
+		}
+		return val * 10, nil
+	})
+	if len(successes) != 2 || len(failures) != 2 {
+		t.Fatalf("expected 2 successes and 2 failures, got %v and %v", successes, failures)
+	}
+	if successes[0].Index != 0 || successes[0].Val != 10 || successes[1].Index != 2 || successes[1].Val != 30 {
+		t.Fatalf("expected successes at 0 and 2, got %v", successes)
+	}
+	if failures[0].Index != 1 || failures[0].Input != 2 || failures[0].Err.Error() != "even 2" {
+		t.Fatalf("expected failure at 1, got %v", failures[0])
+	}
+	if failures[1].Index != 3 || failures[1].Input != 4 {
+		t.Fatalf("expected failure at 3, got %v", failures[1])
+	}
+}
+
+type elementKey struct{}
+
+func TestIterParWithContext(t *testing.T) {
+	ctx := context.Background()
+	arr := []int{1, 2, 3}
+	vals, err := future.IterParWithContext(ctx, arr, func(ctx context.Context, val int) context.Context {
+		return context.WithValue(ctx, elementKey{}, val*100)
+	}, func(ctx context.Context, val int) (int, error) {
+		return ctx.Value(elementKey{}).(int) + val, nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[101 202 303]" {
+		t.Fatalf("expected [101 202 303], got %v", vals)
+	}
+}
+
+func TestConcurrentMap(t *testing.T) {
+	ctx := context.Background()
+	vals, err := future.ConcurrentMap(ctx, []string{"a", "bb", "ccc"}, func(ctx context.Context, val string) (int, error) {
+		return len(val), nil
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[1 2 3]" {
+		t.Fatalf("expected [1 2 3], got %v", vals)
+	}
+}
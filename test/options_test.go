@@ -0,0 +1,228 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+)
+
+type countingExecutor struct {
+	calls int
+}
+
+func (e *countingExecutor) Go(fun func()) {
+	e.calls++
+	go fun()
+}
+
+func TestWithExecutor(t *testing.T) {
+	ctx := context.Background()
+	exec := &countingExecutor{}
+	f := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		return 1, nil
+	}, future.WithExecutor[int](exec))
+
+	val, err := f.TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+	if exec.calls != 1 {
+		t.Fatalf("expected 1 executor call, got %v", exec.calls)
+	}
+}
+
+func TestWithDebug(t *testing.T) {
+	ctx := context.Background()
+	cause := errors.New("error")
+	_, _, line, _ := runtime.Caller(0)
+	f := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		return 0, cause
+	}, future.WithDebug[int](), future.WithName[int]("fetch"))
+
+	_, err := f.TryGet(ctx)
+	var futureErr *future.FutureError
+	if !errors.As(err, &futureErr) {
+		t.Fatalf("expected FutureError, got %v", err)
+	}
+	if !errors.Is(err, cause) {
+		t.Fatalf("expected FutureError to wrap cause, got %v", err)
+	}
+	if futureErr.FutureName != "fetch" {
+		t.Fatalf("expected fetch, got %v", futureErr.FutureName)
+	}
+	if filepath.Base(futureErr.File) != "options_test.go" || futureErr.Line != line+1 {
+		t.Fatalf("expected options_test.go:%d, got %s:%d", line+1, futureErr.File, futureErr.Line)
+	}
+}
+
+func TestWithMaxRetries(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	start := time.Now()
+	val, err := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		calls++
+		if calls < 3 {
+			return 0, errors.New("error")
+		}
+		return calls, nil
+	}, future.WithMaxRetries[int](3), future.WithRetryDelay[int](5*time.Millisecond)).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 3 {
+		t.Fatalf("expected 3, got %v", val)
+	}
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Fatalf("expected two retry delays, got %v", elapsed)
+	}
+}
+
+func TestWithMaxRetriesExhausted(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	_, err := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		calls++
+		return 0, errors.New("error")
+	}, future.WithMaxRetries[int](2)).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %v", calls)
+	}
+}
+
+func TestWithFallback(t *testing.T) {
+	ctx := context.Background()
+	val, err := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		return 0, errors.New("error")
+	}, future.WithFallback(42)).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 42 {
+		t.Fatalf("expected 42, got %v", val)
+	}
+
+	val, err = future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		return 1, nil
+	}, future.WithFallback(42)).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+}
+
+func TestWithRecover(t *testing.T) {
+	ctx := context.Background()
+	_, err := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		panic("boom")
+	}, future.WithRecover[int]()).TryGet(ctx)
+	var panicErr *future.PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected *PanicError, got %v", err)
+	}
+	if panicErr.Value != "boom" {
+		t.Fatalf("expected boom, got %v", panicErr.Value)
+	}
+}
+
+func TestWithLogger(t *testing.T) {
+	ctx := context.Background()
+	var logged []future.State
+	logger := future.WithLogger(func(state future.State, val int, err error) {
+		logged = append(logged, state)
+	})
+
+	future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		return 1, nil
+	}, logger).TryGet(ctx)
+	future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		return 0, errors.New("error")
+	}, logger).TryGet(ctx)
+	if len(logged) != 2 || logged[0] != future.StateDone || logged[1] != future.StateError {
+		t.Fatalf("expected done then error to be logged, got %v", logged)
+	}
+}
+
+func TestWithDeadlinePropagation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	block := make(chan struct{})
+	defer close(block)
+
+	_, err := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		<-block
+		return 1, nil
+	}, future.WithDeadlinePropagation[int]()).TryGet(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	val, err := future.NewWithOptions(context.Background(), func(ctx context.Context) (int, error) {
+		return 1, nil
+	}, future.WithDeadlinePropagation[int]()).TryGet(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+}
+
+func TestWithTimeoutError(t *testing.T) {
+	errFetchTimeout := errors.New("fetch timed out")
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	_, err := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}, future.WithTimeoutError[int](errFetchTimeout)).TryGet(context.Background())
+	if !errors.Is(err, errFetchTimeout) {
+		t.Fatalf("expected fetch timeout, got %v", err)
+	}
+
+	_, err = future.NewWithOptions(context.Background(), func(ctx context.Context) (int, error) {
+		return 0, errors.New("error")
+	}, future.WithTimeoutError[int](errFetchTimeout)).TryGet(context.Background())
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestWithDetached(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	f := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		select {
+		case <-release:
+			return 1, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}, future.WithDetached[int]())
+
+	cancel()
+	close(release)
+	val, err := f.TryGet(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+	if err := f.Context().Err(); err != nil {
+		t.Fatalf("expected detached context not to be canceled, got %v", err)
+	}
+}
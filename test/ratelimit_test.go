@@ -0,0 +1,82 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	rl := future.NewRateLimiter(ctx, 50, func(ctx context.Context) (time.Time, error) {
+		return time.Now(), nil
+	})
+
+	start := time.Now()
+	futures := []*future.Future[time.Time]{
+		rl.Invoke(ctx),
+		rl.Invoke(ctx),
+		rl.Invoke(ctx),
+	}
+	times, err := future.All(ctx, futures)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if elapsed := times[2].Sub(start); elapsed < 35*time.Millisecond {
+		t.Fatalf("expected third call to wait for two intervals, got %v", elapsed)
+	}
+}
+
+func TestRateLimiterCanceled(t *testing.T) {
+	ctx := context.Background()
+	rl := future.NewRateLimiter(ctx, 1, func(ctx context.Context) (int, error) {
+		return 1, nil
+	})
+	if _, err := rl.Invoke(ctx).TryGet(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err := rl.Invoke(waitCtx).TryGet(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestRateLimiterCanceledKeepsToken(t *testing.T) {
+	ctx := context.Background()
+	rl := future.NewRateLimiter(ctx, 10, func(ctx context.Context) (int, error) {
+		return 1, nil
+	})
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	for range 5 {
+		if _, err := rl.Invoke(canceled).TryGet(ctx); !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected canceled, got %v", err)
+		}
+	}
+
+	waitCtx, cancel := context.WithCancel(ctx)
+	pending := rl.Invoke(ctx)
+	abandoned := rl.Invoke(waitCtx)
+	cancel()
+	if _, err := abandoned.TryGet(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled, got %v", err)
+	}
+	if _, err := pending.TryGet(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	start := time.Now()
+	if _, err := rl.Invoke(ctx).TryGet(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 150*time.Millisecond {
+		t.Fatalf("expected canceled calls to give back their tokens, waited %v", elapsed)
+	}
+}
@@ -0,0 +1,65 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestTry(t *testing.T) {
+	ctx := context.Background()
+	f := future.Try(ctx, func(ctx context.Context) int {
+		return 1
+	})
+
+	val, err := f.TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+}
+
+func TestTryPanic(t *testing.T) {
+	ctx := context.Background()
+	var boxed any = "not an int"
+	f := future.Try(ctx, func(ctx context.Context) int {
+		return boxed.(int)
+	})
+
+	_, err := f.TryGet(ctx)
+	var panicErr *future.PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected PanicError, got %v", err)
+	}
+	var typeErr interface{ RuntimeError() }
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("expected runtime error, got %v", err)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	ctx := context.Background()
+	fallback := func(ctx context.Context, err error) string {
+		return "recovered: " + err.Error()
+	}
+
+	val, err := future.Recover(future.Err[string](ctx, errors.New("error")), fallback).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "recovered: error" {
+		t.Fatalf("expected recovered: error, got %v", val)
+	}
+
+	val, err = future.Recover(future.Ok(ctx, "ok"), fallback).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "ok" {
+		t.Fatalf("expected ok, got %v", val)
+	}
+}
@@ -0,0 +1,166 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestRegistry(t *testing.T) {
+	ctx := context.Background()
+	r := future.NewRegistry()
+	release := make(chan struct{})
+	f := future.New(ctx, func(ctx context.Context) (int, error) {
+		<-release
+		return 1, nil
+	})
+	future.Register(r, f, "pending")
+
+	active := r.ActiveFutures()
+	if len(active) != 1 {
+		t.Fatalf("expected 1 active future, got %v", len(active))
+	}
+	if active[0].Name != "pending" {
+		t.Fatalf("expected pending, got %v", active[0].Name)
+	}
+	if active[0].State != future.StatePending {
+		t.Fatalf("expected pending state, got %v", active[0].State)
+	}
+
+	close(release)
+	if err := r.WaitAll(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if active := r.ActiveFutures(); len(active) != 0 {
+		t.Fatalf("expected no active futures, got %v", len(active))
+	}
+}
+
+func TestRegistryWaitAllError(t *testing.T) {
+	ctx := context.Background()
+	r := future.NewRegistry()
+	future.Register(r, future.Ok(ctx, 1), "ok")
+	future.Register(r, future.Err[int](ctx, errors.New("error")), "err")
+
+	err := r.WaitAll(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestRegistryActiveFuturesKeepsFailures(t *testing.T) {
+	ctx := context.Background()
+	r := future.NewRegistry()
+	future.Register(r, future.Err[int](ctx, errors.New("error")), "err")
+
+	deadline := time.Now().Add(time.Second)
+	for len(r.ActiveFutures()) != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected no active futures")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err := r.WaitAll(ctx); err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestNewTracked(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	future.NewTracked(ctx, "tracked", func(ctx context.Context) (int, error) {
+		<-release
+		return 1, nil
+	})
+
+	found := false
+	for _, info := range future.DefaultRegistry().ActiveFutures() {
+		if info.Name == "tracked" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected tracked future to be registered")
+	}
+
+	close(release)
+	if err := future.DefaultRegistry().WaitAll(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	f := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		<-release
+		return 0, errors.New("error")
+	}, future.WithName[int]("fetch"))
+
+	info := future.Describe(f)
+	if info.Name != "fetch" {
+		t.Fatalf("expected fetch, got %v", info.Name)
+	}
+	if info.State != future.StatePending {
+		t.Fatalf("expected pending state, got %v", info.State)
+	}
+	if info.CreatedAt.IsZero() {
+		t.Fatalf("expected creation time to be set")
+	}
+
+	close(release)
+	f.TryGet(ctx)
+	info = future.Describe(f)
+	if info.State != future.StateError || info.Err != "error" {
+		t.Fatalf("expected error state, got %v", info)
+	}
+	if s := info.String(); !strings.HasPrefix(s, "fetch: error after ") || !strings.HasSuffix(s, "ms: error") {
+		t.Fatalf("expected one-line summary, got %v", s)
+	}
+	if s := future.Describe(future.Ok(ctx, 1)).String(); !strings.HasPrefix(s, "future: done after ") {
+		t.Fatalf("expected one-line summary, got %v", s)
+	}
+}
+
+func TestRegistryDropsSettled(t *testing.T) {
+	ctx := context.Background()
+	r := future.NewRegistry()
+	release := make(chan struct{})
+	for range 3 {
+		future.Register(r, future.New(ctx, func(ctx context.Context) (int, error) {
+			<-release
+			return 1, nil
+		}), "ok")
+	}
+	future.Register(r, future.Err[int](ctx, errors.New("error")), "err")
+	if n := r.Len(); n != 4 {
+		t.Fatalf("expected 4 futures, got %v", n)
+	}
+
+	close(release)
+	deadline := time.Now().Add(time.Second)
+	for r.Len() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected settled futures to be dropped, got %v", r.Len())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if err := r.WaitAll(ctx); err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if n := r.Len(); n != 0 {
+		t.Fatalf("expected reported failures to be dropped, got %v", n)
+	}
+}
+
+func TestDescribeNilError(t *testing.T) {
+	info := future.Describe(future.Err[int](context.Background(), nil))
+	if info.State != future.StateError || info.Err != "" {
+		t.Fatalf("expected error state without message, got %v", info)
+	}
+}
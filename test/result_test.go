@@ -0,0 +1,103 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestGetAttempt(t *testing.T) {
+	ctx := context.Background()
+	a := future.Ok(ctx, 1).GetAttempt(ctx)
+	if !a.IsOk() {
+		t.Fatalf("expected ok attempt, got %v", a.UnwrapErr())
+	}
+	if a.Unwrap() != 1 {
+		t.Fatalf("expected 1, got %v", a.Unwrap())
+	}
+	if a.UnwrapOr(2) != 1 {
+		t.Fatalf("expected 1, got %v", a.UnwrapOr(2))
+	}
+}
+
+func TestGetAttemptError(t *testing.T) {
+	ctx := context.Background()
+	a := future.Err[int](ctx, errors.New("error")).GetAttempt(ctx)
+	if a.IsOk() {
+		t.Fatalf("expected failed attempt")
+	}
+	if a.UnwrapErr() == nil || a.UnwrapErr().Error() != "error" {
+		t.Fatalf("expected error, got %v", a.UnwrapErr())
+	}
+	if a.UnwrapOr(2) != 2 {
+		t.Fatalf("expected 2, got %v", a.UnwrapOr(2))
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected panic")
+		}
+	}()
+	a.Unwrap()
+}
+
+func TestGetOption(t *testing.T) {
+	ctx := context.Background()
+	o := future.Ok(ctx, 1).GetOption(ctx)
+	if !o.IsSome() {
+		t.Fatalf("expected some value")
+	}
+	if val, ok := o.Get(); !ok || val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+
+	o = future.Err[int](ctx, errors.New("error")).GetOption(ctx)
+	if o.IsSome() {
+		t.Fatalf("expected no value")
+	}
+	if val := o.GetOr(2); val != 2 {
+		t.Fatalf("expected 2, got %v", val)
+	}
+}
+
+func TestOptional(t *testing.T) {
+	if val := future.Some(1).GetOr(2); val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+	if _, ok := future.None[int]().Get(); ok {
+		t.Fatalf("expected no value")
+	}
+}
+
+func TestGetResult(t *testing.T) {
+	ctx := context.Background()
+	r := future.GetResult(ctx, future.Ok(ctx, 2))
+	if r.Err != nil || r.Val != 2 {
+		t.Fatalf("expected 2, got %v", r)
+	}
+
+	r = future.GetResult(ctx, future.Err[int](ctx, errors.New("error")))
+	if r.Err == nil || r.Err.Error() != "error" {
+		t.Fatalf("expected error, got %v", r.Err)
+	}
+}
+
+func TestMapResult(t *testing.T) {
+	ctx := context.Background()
+	half := func(val int) future.Result[int] {
+		if val%2 != 0 {
+			return future.Result[int]{Err: errors.New("odd")}
+		}
+		return future.Result[int]{Val: val / 2}
+	}
+
+	r := future.MapResult(future.GetResult(ctx, future.Ok(ctx, 4)), half)
+	if r.Err != nil || r.Val != 2 {
+		t.Fatalf("expected 2, got %v", r)
+	}
+	r = future.MapResult(future.MapResult(r, half), half)
+	if r.Err == nil || r.Err.Error() != "odd" {
+		t.Fatalf("expected odd error, got %v", r.Err)
+	}
+}
@@ -0,0 +1,186 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+)
+
+var errTransient = errors.New("transient")
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	val, err := future.Retry(ctx, 5, func(ctx context.Context) (int, error) {
+		calls++
+		if calls < 3 {
+			return 0, errTransient
+		}
+		return calls, nil
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 3 {
+		t.Fatalf("expected 3, got %v", val)
+	}
+
+	calls = 0
+	_, err = future.Retry(ctx, 3, func(ctx context.Context) (int, error) {
+		calls++
+		return 0, fmt.Errorf("attempt %d", calls)
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "attempt 3" {
+		t.Fatalf("expected last error, got %v", err)
+	}
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	var backoffs []int
+	_, err := future.RetryWithBackoff(ctx, 3, func(attempt int) time.Duration {
+		backoffs = append(backoffs, attempt)
+		return time.Millisecond << attempt
+	}, func(ctx context.Context) (int, error) {
+		return 0, errTransient
+	}).TryGet(ctx)
+	if !errors.Is(err, errTransient) {
+		t.Fatalf("expected transient error, got %v", err)
+	}
+	if len(backoffs) != 2 || backoffs[0] != 1 || backoffs[1] != 2 {
+		t.Fatalf("expected backoff after attempts 1 and 2, got %v", backoffs)
+	}
+}
+
+func TestRetryIf(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	val, err := future.RetryIf(ctx, 5, func(attempt int, err error) bool {
+		return errors.Is(err, errTransient)
+	}, func(ctx context.Context) (int, error) {
+		calls++
+		if calls < 3 {
+			return 0, errTransient
+		}
+		return calls, nil
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 3 {
+		t.Fatalf("expected 3, got %v", val)
+	}
+}
+
+func TestRetryIfStops(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	var attempts []int
+	_, err := future.RetryIf(ctx, 5, func(attempt int, err error) bool {
+		attempts = append(attempts, attempt)
+		return errors.Is(err, errTransient)
+	}, func(ctx context.Context) (int, error) {
+		calls++
+		if calls < 2 {
+			return 0, errTransient
+		}
+		return 0, errors.New("permanent")
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "permanent" {
+		t.Fatalf("expected permanent error, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %v", calls)
+	}
+	if len(attempts) != 2 || attempts[0] != 1 || attempts[1] != 2 {
+		t.Fatalf("expected attempts [1 2], got %v", attempts)
+	}
+}
+
+func TestRetryIfExhausted(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	_, err := future.RetryIf(ctx, 3, func(attempt int, err error) bool {
+		return true
+	}, func(ctx context.Context) (int, error) {
+		calls++
+		return 0, errTransient
+	}).TryGet(ctx)
+	if !errors.Is(err, errTransient) {
+		t.Fatalf("expected transient error, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %v", calls)
+	}
+}
+
+func TestSpreadLoad(t *testing.T) {
+	ctx := context.Background()
+	var calls []int
+	endpoint := func(i int, err error) func(ctx context.Context) (int, error) {
+		return func(ctx context.Context) (int, error) {
+			calls = append(calls, i)
+			return i, err
+		}
+	}
+	endpoints := []func(ctx context.Context) (int, error){
+		endpoint(0, nil),
+		endpoint(1, errTransient),
+		endpoint(2, errTransient),
+	}
+
+	val, err := future.SpreadLoad(ctx, endpoints, func(endpoints []func(ctx context.Context) (int, error)) int {
+		return 1
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 0 {
+		t.Fatalf("expected 0, got %v", val)
+	}
+	if len(calls) != 3 || calls[0] != 1 || calls[1] != 2 || calls[2] != 0 {
+		t.Fatalf("expected endpoints 1, 2, 0 to be called, got %v", calls)
+	}
+}
+
+func TestSpreadLoadExhausted(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	failing := func(ctx context.Context) (int, error) {
+		calls++
+		return 0, errTransient
+	}
+	_, err := future.SpreadLoad(ctx, []func(ctx context.Context) (int, error){failing, failing}, func(endpoints []func(ctx context.Context) (int, error)) int {
+		return 0
+	}).TryGet(ctx)
+	if !errors.Is(err, errTransient) {
+		t.Fatalf("expected transient error, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %v", calls)
+	}
+}
+
+func TestSpreadLoadOutOfRange(t *testing.T) {
+	ctx := context.Background()
+	endpoints := []func(ctx context.Context) (int, error){
+		func(ctx context.Context) (int, error) { return 0, nil },
+		func(ctx context.Context) (int, error) { return 1, nil },
+		func(ctx context.Context) (int, error) { return 2, nil },
+	}
+	for pick, expected := range map[int]int{-1: 2, -4: 2, 4: 1} {
+		val, err := future.SpreadLoad(ctx, endpoints, func(endpoints []func(ctx context.Context) (int, error)) int {
+			return pick
+		}).TryGet(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if val != expected {
+			t.Fatalf("expected endpoint %v for pick %v, got %v", expected, pick, val)
+		}
+	}
+}
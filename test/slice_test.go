@@ -0,0 +1,265 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestHead(t *testing.T) {
+	ctx := context.Background()
+	val, err := future.Head(future.Ok(ctx, []int{1, 2, 3})).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+
+	_, err = future.Head(future.Ok(ctx, []int{})).TryGet(ctx)
+	if !errors.Is(err, future.ErrEmptySlice) {
+		t.Fatalf("expected ErrEmptySlice, got %v", err)
+	}
+}
+
+func TestTail(t *testing.T) {
+	ctx := context.Background()
+	vals, err := future.Tail(future.Ok(ctx, []int{1, 2, 3})).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(vals) != 2 || vals[0] != 2 || vals[1] != 3 {
+		t.Fatalf("expected [2 3], got %v", vals)
+	}
+
+	_, err = future.Tail(future.Ok(ctx, []int{})).TryGet(ctx)
+	if !errors.Is(err, future.ErrEmptySlice) {
+		t.Fatalf("expected ErrEmptySlice, got %v", err)
+	}
+}
+
+func TestFlattenSingle(t *testing.T) {
+	ctx := context.Background()
+	val, err := future.FlattenSingle(future.Ok(ctx, []int{1})).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+
+	for _, vals := range [][]int{{}, {1, 2}} {
+		_, err = future.FlattenSingle(future.Ok(ctx, vals)).TryGet(ctx)
+		if !errors.Is(err, future.ErrExpectedExactlyOne) {
+			t.Fatalf("expected ErrExpectedExactlyOne, got %v", err)
+		}
+	}
+}
+
+func TestFutureSlice(t *testing.T) {
+	ctx := context.Background()
+	fs := future.NewFutureSlice(future.Ok(ctx, 1))
+	appended := fs.Append(future.Ok(ctx, 2))
+	if fs.Len() != 1 {
+		t.Fatalf("expected Append not to modify the receiver, got %v", fs.Len())
+	}
+	if appended.Len() != 2 {
+		t.Fatalf("expected 2 futures, got %v", appended.Len())
+	}
+
+	vals, err := appended.All(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(vals) != 2 || vals[0] != 1 || vals[1] != 2 {
+		t.Fatalf("expected [1 2], got %v", vals)
+	}
+}
+
+func TestFutureSliceAny(t *testing.T) {
+	ctx := context.Background()
+	fs := future.NewFutureSlice(
+		future.Err[int](ctx, errors.New("error")),
+		future.Ok(ctx, 2),
+	)
+
+	val, err := fs.Any(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 2 {
+		t.Fatalf("expected 2, got %v", val)
+	}
+
+	fs = future.NewFutureSlice(
+		future.Err[int](ctx, errors.New("error a")),
+		future.Err[int](ctx, errors.New("error b")),
+	)
+	_, err = fs.Any(ctx)
+	var aggErr *future.AggregateError
+	if !errors.As(err, &aggErr) || len(aggErr.Errors()) != 2 {
+		t.Fatalf("expected AggregateError with 2 errors, got %v", err)
+	}
+}
+
+func TestFutureSliceAllSettled(t *testing.T) {
+	ctx := context.Background()
+	fs := future.NewFutureSlice(
+		future.Ok(ctx, 1),
+		future.Err[int](ctx, errors.New("error")),
+	)
+
+	results := fs.AllSettled(ctx)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %v", len(results))
+	}
+	if results[0].Err != nil || results[0].Val != 1 {
+		t.Fatalf("expected 1, got %v", results[0])
+	}
+	if results[1].Err == nil || results[1].Err.Error() != "error" {
+		t.Fatalf("expected error, got %v", results[1].Err)
+	}
+}
+
+func TestFutureSliceShuffle(t *testing.T) {
+	ctx := context.Background()
+	fs := future.NewFutureSlice[int]()
+	for i := range 20 {
+		fs = fs.Append(future.Ok(ctx, i))
+	}
+
+	shuffled := fs.Shuffle()
+	if shuffled.Len() != fs.Len() {
+		t.Fatalf("expected %d futures, got %v", fs.Len(), shuffled.Len())
+	}
+	vals, err := fs.All(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for i, val := range vals {
+		if val != i {
+			t.Fatalf("expected receiver to be unchanged, got %v at %d", val, i)
+		}
+	}
+	shuffledVals, err := shuffled.All(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	slices.Sort(shuffledVals)
+	if !slices.Equal(vals, shuffledVals) {
+		t.Fatalf("expected the same futures, got %v", shuffledVals)
+	}
+}
+
+func TestParallel(t *testing.T) {
+	ctx := context.Background()
+	p := future.NewParallel[int]().
+		Add(future.Ok(ctx, 1)).
+		Add(future.Ok(ctx, 2))
+
+	vals, err := p.All(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(vals) != 2 || vals[0] != 1 || vals[1] != 2 {
+		t.Fatalf("expected [1 2], got %v", vals)
+	}
+
+	p.Add(future.Err[int](ctx, errors.New("error")))
+	if _, err := p.All(ctx); err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if _, err := p.Any(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	results := p.AllSettled(ctx)
+	if len(results) != 3 || results[2].Err == nil {
+		t.Fatalf("expected third result to fail, got %v", results)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	ctx := context.Background()
+	c := &future.Concurrent{}
+	n := future.AddConcurrent(c, future.Ok(ctx, 1))
+	s := future.AddConcurrent(c, future.Ok(ctx, "a"))
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("expected Value to panic before Wait")
+			}
+		}()
+		n.Value()
+	}()
+
+	if err := c.Wait(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if n.Value() != 1 {
+		t.Fatalf("expected 1, got %v", n.Value())
+	}
+	if s.Value() != "a" {
+		t.Fatalf("expected a, got %v", s.Value())
+	}
+
+	c = &future.Concurrent{}
+	future.AddConcurrent(c, future.Err[int](ctx, errors.New("error")))
+	if err := c.Wait(ctx); err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestConcurrentFailFast(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	c := &future.Concurrent{}
+	slow := future.AddConcurrent(c, future.New(ctx, func(ctx context.Context) (int, error) {
+		<-release
+		return 1, nil
+	}))
+	future.AddConcurrent(c, future.Err[string](ctx, errors.New("error")))
+
+	if err := c.Wait(ctx); err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	close(release)
+	time.Sleep(10 * time.Millisecond)
+	if val := slow.Value(); val != 0 {
+		t.Fatalf("expected 0, got %v", val)
+	}
+}
+
+func TestConcurrentValueDuringWait(t *testing.T) {
+	ctx := context.Background()
+	release := make(chan struct{})
+	c := &future.Concurrent{}
+	h := future.AddConcurrent(c, future.New(ctx, func(ctx context.Context) (int, error) {
+		<-release
+		return 1, nil
+	}))
+
+	waited := make(chan error)
+	go func() {
+		waited <- c.Wait(ctx)
+	}()
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatalf("expected Value to panic before Wait returns")
+			}
+		}()
+		h.Value()
+	}()
+	close(release)
+	if err := <-waited; err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if h.Value() != 1 {
+		t.Fatalf("expected 1, got %v", h.Value())
+	}
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+
+	futuresql "github.com/Olian04/go-future/future/sql"
+)
+
+// fakeDriver answers every query with the rows 1, 2 and 3 in a single column.
+type fakeDriver struct {
+	closedRows atomic.Int32
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+}
+
+type fakeRows struct {
+	driver *fakeDriver
+	next   int64
+}
+
+var testDriver = &fakeDriver{}
+
+func init() {
+	sql.Register("futuretest", testDriver)
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{driver: d}, nil
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &fakeRows{driver: c.driver}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"n"}
+}
+
+func (r *fakeRows) Close() error {
+	r.driver.closedRows.Add(1)
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.next == 3 {
+		return io.EOF
+	}
+	r.next++
+	dest[0] = r.next
+	return nil
+}
+
+func TestNewFromQuery(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("futuretest", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer db.Close()
+
+	closed := testDriver.closedRows.Load()
+	sum, err := futuresql.NewFromQuery(ctx, db, "SELECT n", nil, func(rows *sql.Rows) (int, error) {
+		sum := 0
+		for rows.Next() {
+			var n int
+			if err := rows.Scan(&n); err != nil {
+				return 0, err
+			}
+			sum += n
+		}
+		return sum, nil
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if sum != 6 {
+		t.Fatalf("expected 6, got %v", sum)
+	}
+	if testDriver.closedRows.Load() == closed {
+		t.Fatalf("expected rows to be closed")
+	}
+}
+
+func TestNewFromQueryScanError(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("futuretest", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer db.Close()
+
+	closed := testDriver.closedRows.Load()
+	_, err = futuresql.NewFromQuery(ctx, db, "SELECT n", nil, func(rows *sql.Rows) (int, error) {
+		return 0, errors.New("error")
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if testDriver.closedRows.Load() == closed {
+		t.Fatalf("expected rows to be closed")
+	}
+}
+
+func TestNewFromRow(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("futuretest", "")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer db.Close()
+
+	val, err := futuresql.NewFromRow(ctx, db, "SELECT n", nil, func(row *sql.Row) (int, error) {
+		var n int
+		err := row.Scan(&n)
+		return n, err
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+}
@@ -0,0 +1,268 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestStream(t *testing.T) {
+	ctx := context.Background()
+	s := future.NewStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		for i := range 3 {
+			if err := emit(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	for i := range 3 {
+		val, ok, err := s.Next(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !ok {
+			t.Fatalf("expected a value")
+		}
+		if val != i {
+			t.Fatalf("expected %d, got %v", i, val)
+		}
+	}
+
+	for range 2 {
+		_, ok, err := s.Next(ctx)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if ok {
+			t.Fatalf("expected end of stream")
+		}
+	}
+}
+
+func TestStreamError(t *testing.T) {
+	ctx := context.Background()
+	s := future.NewStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		if err := emit(1); err != nil {
+			return err
+		}
+		return errors.New("error")
+	})
+
+	if val, ok, err := s.Next(ctx); err != nil || !ok || val != 1 {
+		t.Fatalf("expected 1, got %v %v %v", val, ok, err)
+	}
+	_, ok, err := s.Next(ctx)
+	if ok {
+		t.Fatalf("expected end of stream")
+	}
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestStreamNextCanceled(t *testing.T) {
+	release := make(chan struct{})
+	s := future.NewStream(context.Background(), func(ctx context.Context, emit func(int) error) error {
+		<-release
+		return emit(1)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := s.Next(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+
+	close(release)
+	val, ok, err := s.Next(context.Background())
+	if err != nil || !ok || val != 1 {
+		t.Fatalf("expected 1, got %v %v %v", val, ok, err)
+	}
+}
+
+func countTo(ctx context.Context, n int) *future.Stream[int] {
+	return future.NewStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		for i := range n {
+			if err := emit(i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func drain[T any](t *testing.T, ctx context.Context, s *future.Stream[T]) ([]T, error) {
+	t.Helper()
+	var vals []T
+	for {
+		val, ok, err := s.Next(ctx)
+		if !ok {
+			return vals, err
+		}
+		vals = append(vals, val)
+	}
+}
+
+func TestStreamMap(t *testing.T) {
+	ctx := context.Background()
+	calls := 0
+	s := future.StreamMap(countTo(ctx, 3), func(ctx context.Context, val int) (string, error) {
+		calls++
+		return fmt.Sprintf("%d", val*2), nil
+	})
+	if calls != 0 {
+		t.Fatalf("expected transform to be lazy, got %v calls", calls)
+	}
+
+	vals, err := drain(t, ctx, s)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[0 2 4]" {
+		t.Fatalf("expected [0 2 4], got %v", vals)
+	}
+}
+
+func TestStreamMapError(t *testing.T) {
+	ctx := context.Background()
+	s := future.StreamMap(countTo(ctx, 3), func(ctx context.Context, val int) (int, error) {
+		if val == 1 {
+			return 0, errors.New("error")
+		}
+		return val, nil
+	})
+
+	vals, err := drain(t, ctx, s)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if len(vals) != 1 {
+		t.Fatalf("expected 1 value, got %v", vals)
+	}
+}
+
+func TestStreamFilter(t *testing.T) {
+	ctx := context.Background()
+	s := future.StreamFilter(countTo(ctx, 6), func(ctx context.Context, val int) bool {
+		return val%2 == 0
+	})
+
+	vals, err := drain(t, ctx, s)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[0 2 4]" {
+		t.Fatalf("expected [0 2 4], got %v", vals)
+	}
+}
+
+func TestStreamFilterErr(t *testing.T) {
+	ctx := context.Background()
+	s := future.StreamFilterErr(countTo(ctx, 6), func(ctx context.Context, val int) (bool, error) {
+		if val == 3 {
+			return false, errors.New("error")
+		}
+		return true, nil
+	})
+
+	vals, err := drain(t, ctx, s)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[0 1 2]" {
+		t.Fatalf("expected [0 1 2], got %v", vals)
+	}
+}
+
+func TestStreamFilterCanceled(t *testing.T) {
+	s := future.StreamFilter(countTo(context.Background(), 6), func(ctx context.Context, val int) bool {
+		return false
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := s.Next(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+}
+
+func TestStreamTake(t *testing.T) {
+	ctx := context.Background()
+	stopped := make(chan error, 1)
+	s := future.NewStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		for i := 0; ; i++ {
+			if err := emit(i); err != nil {
+				stopped <- err
+				return err
+			}
+		}
+	})
+
+	vals, err := future.StreamCollect(ctx, future.StreamTake(s, 3)).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if fmt.Sprint(vals) != "[0 1 2]" {
+		t.Fatalf("expected [0 1 2], got %v", vals)
+	}
+	if err := <-stopped; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected producer to be canceled, got %v", err)
+	}
+}
+
+func TestStreamCollectError(t *testing.T) {
+	ctx := context.Background()
+	s := future.NewStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		emit(1)
+		return errors.New("error")
+	})
+
+	_, err := future.StreamCollect(ctx, s).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
+
+func TestStreamMerge(t *testing.T) {
+	ctx := context.Background()
+	s := future.StreamMerge(ctx, countTo(ctx, 3), countTo(ctx, 2))
+
+	vals, err := drain(t, ctx, s)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	slices.Sort(vals)
+	if fmt.Sprint(vals) != "[0 0 1 1 2]" {
+		t.Fatalf("expected [0 0 1 1 2], got %v", vals)
+	}
+}
+
+func TestStreamMergeError(t *testing.T) {
+	ctx := context.Background()
+	stopped := make(chan error, 1)
+	endless := future.NewStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		for {
+			if err := emit(1); err != nil {
+				stopped <- err
+				return err
+			}
+		}
+	})
+	failing := future.NewStream(ctx, func(ctx context.Context, emit func(int) error) error {
+		return errors.New("error")
+	})
+
+	_, err := drain(t, ctx, future.StreamMerge(ctx, endless, failing))
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if err := <-stopped; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected remaining input to be canceled, got %v", err)
+	}
+}
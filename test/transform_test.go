@@ -0,0 +1,230 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Olian04/go-future/future"
+)
+
+func TestCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	saved := 0
+	val, err := future.Checkpoint(future.Ok(ctx, 1), func(ctx context.Context, val int) error {
+		saved = val
+		return nil
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+	if saved != 1 {
+		t.Fatalf("expected 1 to be saved, got %v", saved)
+	}
+}
+
+func TestCheckpointError(t *testing.T) {
+	ctx := context.Background()
+	called := false
+	_, err := future.Checkpoint(future.Err[int](ctx, errors.New("error")), func(ctx context.Context, val int) error {
+		called = true
+		return nil
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if called {
+		t.Fatalf("expected save not to be called")
+	}
+
+	_, err = future.Checkpoint(future.Ok(ctx, 1), func(ctx context.Context, val int) error {
+		return errors.New("save error")
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "save error" {
+		t.Fatalf("expected save error, got %v", err)
+	}
+}
+
+func TestCheckpointWithRollback(t *testing.T) {
+	ctx := context.Background()
+	saved := 0
+	save := func(ctx context.Context, val int) error {
+		saved += val
+		return nil
+	}
+	rollback := func(ctx context.Context, val int) error {
+		saved -= val
+		return nil
+	}
+
+	c := future.CheckpointWithRollback(future.Ok(ctx, 2), save, rollback)
+	val, err := c.Result().TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 2 || saved != 2 {
+		t.Fatalf("expected 2 to be saved, got %v", saved)
+	}
+	if err := c.Rollback(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if saved != 0 {
+		t.Fatalf("expected save to be rolled back, got %v", saved)
+	}
+
+	c = future.CheckpointWithRollback(future.Err[int](ctx, errors.New("error")), save, rollback)
+	if err := c.Rollback(ctx); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if saved != 0 {
+		t.Fatalf("expected nothing to be rolled back, got %v", saved)
+	}
+}
+
+func TestWithBackground(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	f := future.NewWithOptions(ctx, func(ctx context.Context) (int, error) {
+		select {
+		case <-release:
+			return 1, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}, future.WithDetached[int]())
+
+	background := future.WithBackground(f)
+	cancel()
+	close(release)
+
+	val, err := background.TryGet(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+	if err := background.Context().Err(); err != nil {
+		t.Fatalf("expected background context not to be canceled, got %v", err)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	ctx := context.Background()
+	var logged []future.State
+	logger := func(state future.State, val int, err error) {
+		logged = append(logged, state)
+	}
+
+	val, err := future.Inspect(future.Ok(ctx, 1), logger).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+
+	_, err = future.Inspect(future.Err[int](ctx, errors.New("error")), logger).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+
+	if len(logged) != 2 || logged[0] != future.StateDone || logged[1] != future.StateError {
+		t.Fatalf("expected done then error to be logged, got %v", logged)
+	}
+}
+
+func TestNarrow(t *testing.T) {
+	ctx := context.Background()
+	val, err := future.Narrow[any, int](future.Ok[any](ctx, 1)).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 {
+		t.Fatalf("expected 1, got %v", val)
+	}
+}
+
+func TestNarrowError(t *testing.T) {
+	ctx := context.Background()
+	_, err := future.Narrow[any, int](future.Ok[any](ctx, "1")).TryGet(ctx)
+	var typeErr *future.TypeAssertionError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("expected TypeAssertionError, got %v", err)
+	}
+	if typeErr.Actual != "string" || typeErr.Expected != "int" {
+		t.Fatalf("expected string and int, got %v and %v", typeErr.Actual, typeErr.Expected)
+	}
+}
+
+type shape interface {
+	Area() int
+}
+
+type square struct {
+	side int
+}
+
+func (s square) Area() int {
+	return s.side * s.side
+}
+
+func TestWiden(t *testing.T) {
+	ctx := context.Background()
+	s, err := future.Widen[square, shape](future.Ok(ctx, square{side: 2})).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if s.Area() != 4 {
+		t.Fatalf("expected 4, got %v", s.Area())
+	}
+
+	pending := future.New(ctx, func(ctx context.Context) (square, error) {
+		return square{side: 3}, nil
+	})
+	s, err = future.Widen[square, shape](pending).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if s.Area() != 9 {
+		t.Fatalf("expected 9, got %v", s.Area())
+	}
+}
+
+func TestWidenMismatch(t *testing.T) {
+	ctx := context.Background()
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected panic")
+		}
+	}()
+	future.Widen[int, shape](future.Ok(ctx, 1))
+}
+
+func TestDo(t *testing.T) {
+	ctx := context.Background()
+	seen := 0
+	val, err := future.Do(future.Ok(ctx, 1), func(ctx context.Context, val int) {
+		seen = val
+	}).TryGet(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != 1 || seen != 1 {
+		t.Fatalf("expected 1, got %v and %v", val, seen)
+	}
+
+	called := false
+	_, err = future.Do(future.Err[int](ctx, errors.New("error")), func(ctx context.Context, val int) {
+		called = true
+	}).TryGet(ctx)
+	if err == nil || err.Error() != "error" {
+		t.Fatalf("expected error, got %v", err)
+	}
+	if called {
+		t.Fatalf("expected fun not to be called")
+	}
+}